@@ -0,0 +1,180 @@
+package bfh
+
+import (
+	"io"
+	"unsafe"
+)
+
+// EncodedLen returns the length of the normal mode encoding of n bytes of data
+func EncodedLen(n int) int {
+	return StdEncoding.EncodedLen(n)
+}
+
+// EncodedStrictLen returns the length of the strict mode encoding of n bytes of data, n must be dividable by 5
+func EncodedStrictLen(n int) int {
+	return StdEncoding.EncodedStrictLen(n)
+}
+
+// DecodedLen returns the maximum length of the data decoded from encodedLen characters
+func DecodedLen(encodedLen int) int {
+	return StdEncoding.DecodedLen(encodedLen)
+}
+
+// AppendEncode appends the normal mode encoding of src to dst and returns the extended buffer
+func AppendEncode(dst, src []byte) []byte {
+	return StdEncoding.AppendEncode(dst, src)
+}
+
+// AppendEncodeStrict appends the strict mode encoding of src to dst and returns the extended buffer
+func AppendEncodeStrict(dst, src []byte) ([]byte, error) {
+	return StdEncoding.AppendEncodeStrict(dst, src)
+}
+
+// AppendDecode appends the data decoded from the normal mode src to dst and returns the extended buffer
+func AppendDecode(dst, src []byte) ([]byte, error) {
+	return StdEncoding.AppendDecode(dst, src)
+}
+
+// AppendDecodeStrict appends the data decoded from the strict mode src to dst and returns the extended buffer
+func AppendDecodeStrict(dst, src []byte) ([]byte, error) {
+	return StdEncoding.AppendDecodeStrict(dst, src)
+}
+
+// EncodeTo writes the normal mode encoding of src into dst and returns the number of bytes written
+func EncodeTo(dst, src []byte) (int, error) {
+	return StdEncoding.EncodeTo(dst, src)
+}
+
+// EncodeStrictTo writes the strict mode encoding of src into dst and returns the number of bytes written
+func EncodeStrictTo(dst, src []byte) (int, error) {
+	return StdEncoding.EncodeStrictTo(dst, src)
+}
+
+// EncodedLen returns the length of the normal mode encoding of n bytes of data
+// Without a padding header normal mode is strict mode, therefore n must be dividable by 5.
+func (enc *Encoding) EncodedLen(n int) int {
+	if !enc.paddingHeader {
+		return enc.EncodedStrictLen(n)
+	}
+
+	return enc.headerLength() + enc.groupedLength((n+4)/5*8)
+}
+
+// EncodedStrictLen returns the length of the strict mode encoding of n bytes of data, n must be dividable by 5
+func (enc *Encoding) EncodedStrictLen(n int) int {
+	return enc.groupedLength(n * 8 / 5)
+}
+
+// DecodedLen returns the maximum length of the data decoded from encodedLen characters
+// The actual length depends on the number of separators and the padding, it is never more than DecodedLen though.
+func (enc *Encoding) DecodedLen(encodedLen int) int {
+	if encodedLen < 0 {
+		return 0
+	}
+
+	return encodedLen / 8 * 5
+}
+
+// AppendEncode appends the normal mode encoding of src to dst and returns the extended buffer
+// A nil src is encoded as empty data. Without a padding header normal mode is strict mode, in which case src is only
+// encoded if its length is dividable by 5, otherwise dst is returned unchanged. Encodings without a padding header
+// should use AppendEncodeStrict instead, which reports the invalid length as an error.
+func (enc *Encoding) AppendEncode(dst, src []byte) []byte {
+	if !enc.paddingHeader {
+		// dst is returned unchanged on failure
+		result, _ := enc.AppendEncodeStrict(dst, src)
+
+		return result
+	}
+
+	dst, result := grow(dst, enc.EncodedLen(len(src)))
+	enc.encodeNormal(result, src)
+
+	return dst
+}
+
+// AppendEncodeStrict appends the strict mode encoding of src to dst and returns the extended buffer
+// A nil src is encoded as empty data.
+func (enc *Encoding) AppendEncodeStrict(dst, src []byte) ([]byte, error) {
+	if len(src)%5 != 0 {
+		return dst, strictLengthError()
+	}
+
+	dst, result := grow(dst, enc.EncodedStrictLen(len(src)))
+	enc.encodeStrict(result, src)
+
+	return dst, nil
+}
+
+// AppendDecode appends the data decoded from the normal mode src to dst and returns the extended buffer
+// On failure dst is returned unchanged.
+func (enc *Encoding) AppendDecode(dst, src []byte) ([]byte, error) {
+	return enc.appendDecode(dst, bytesToString(src))
+}
+
+// AppendDecodeStrict appends the data decoded from the strict mode src to dst and returns the extended buffer
+// On failure dst is returned unchanged.
+func (enc *Encoding) AppendDecodeStrict(dst, src []byte) ([]byte, error) {
+	return enc.appendDecodeStrict(dst, bytesToString(src))
+}
+
+// EncodeTo writes the normal mode encoding of src into dst and returns the number of bytes written
+// io.ErrShortBuffer is returned if dst is shorter than EncodedLen(len(src)).
+func (enc *Encoding) EncodeTo(dst, src []byte) (int, error) {
+	if !enc.paddingHeader {
+		return enc.EncodeStrictTo(dst, src)
+	}
+
+	if src == nil {
+		return 0, ErrNilInput
+	}
+
+	n := enc.EncodedLen(len(src))
+	if len(dst) < n {
+		return 0, io.ErrShortBuffer
+	}
+
+	enc.encodeNormal(dst[:n], src)
+
+	return n, nil
+}
+
+// EncodeStrictTo writes the strict mode encoding of src into dst and returns the number of bytes written
+// io.ErrShortBuffer is returned if dst is shorter than EncodedStrictLen(len(src)).
+func (enc *Encoding) EncodeStrictTo(dst, src []byte) (int, error) {
+	if src == nil {
+		return 0, ErrNilInput
+	}
+
+	if len(src)%5 != 0 {
+		return 0, strictLengthError()
+	}
+
+	n := enc.EncodedStrictLen(len(src))
+	if len(dst) < n {
+		return 0, io.ErrShortBuffer
+	}
+
+	enc.encodeStrict(dst[:n], src)
+
+	return n, nil
+}
+
+// grow extends dst by n bytes, reallocating only if its capacity is not enough, and returns the extended buffer along
+// with the n bytes added
+func grow(dst []byte, n int) ([]byte, []byte) {
+	total := len(dst) + n
+	if total > cap(dst) {
+		grown := make([]byte, len(dst), total)
+		copy(grown, dst)
+		dst = grown
+	}
+
+	return dst[:total], dst[len(dst):total]
+}
+
+// bytesToString returns a string sharing its memory with b to avoid copying
+// It must only be used if b is not modified while the string is in use, e.g. during the call it is passed to.
+func bytesToString(b []byte) string {
+	return *(*string)(unsafe.Pointer(&b)) // #nosec
+}
@@ -0,0 +1,344 @@
+package bfh
+
+import (
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EncodedLen(t *testing.T) {
+	tests := []struct {
+		Name                 string
+		Data                 []byte
+		ExpectedLength       int
+		ExpectedStrictLength int
+	}{
+		{
+			Name:                 "empty",
+			Data:                 []byte{},
+			ExpectedLength:       2,
+			ExpectedStrictLength: 0,
+		},
+		{
+			Name:           "one byte",
+			Data:           []byte{255},
+			ExpectedLength: 11,
+		},
+		{
+			Name:                 "one packet",
+			Data:                 []byte{255, 32, 167, 0, 253},
+			ExpectedLength:       11,
+			ExpectedStrictLength: 9,
+		},
+		{
+			Name:           "six bytes",
+			Data:           []byte{255, 32, 167, 0, 253, 17},
+			ExpectedLength: 21,
+		},
+		{
+			Name:                 "two packets",
+			Data:                 []byte{255, 32, 167, 0, 253, 17, 215, 43, 0, 0},
+			ExpectedLength:       21,
+			ExpectedStrictLength: 19,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			encoded, err := EncodeStr(tt.Data)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.ExpectedLength, EncodedLen(len(tt.Data)))
+			assert.Len(t, encoded, tt.ExpectedLength)
+			assert.True(t, len(tt.Data) <= DecodedLen(len(encoded)))
+
+			if len(tt.Data)%5 != 0 {
+				return
+			}
+
+			encoded, err = EncodeStrictStr(tt.Data)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.ExpectedStrictLength, EncodedStrictLen(len(tt.Data)))
+			assert.Len(t, encoded, tt.ExpectedStrictLength)
+			assert.True(t, len(tt.Data) <= DecodedLen(len(encoded)))
+		})
+	}
+
+	t.Run("custom encoding", func(t *testing.T) {
+		enc := MustNewEncoding(digits, WithGroupLength(0))
+
+		assert.Equal(t, 17, enc.EncodedLen(6))
+		assert.Equal(t, 16, enc.EncodedStrictLen(10))
+	})
+}
+
+func Test_AppendEncode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Dst            []byte
+			Data           []byte
+			ExpectedResult string
+		}{
+			{
+				Name:           "nil dst",
+				Dst:            nil,
+				Data:           []byte{255, 32, 167, 0, 253, 17},
+				ExpectedResult: "4-zwga-e07x-2400-0000",
+			},
+			{
+				Name:           "prefix",
+				Dst:            []byte("id:"),
+				Data:           []byte{255, 32, 167, 0, 253, 17},
+				ExpectedResult: "id:4-zwga-e07x-2400-0000",
+			},
+			{
+				Name:           "nil data",
+				Dst:            []byte("id:"),
+				Data:           nil,
+				ExpectedResult: "id:0-",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult := AppendEncode(tt.Dst, tt.Data)
+
+				assert.Equal(t, tt.ExpectedResult, string(actualResult))
+			})
+		}
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		actualResult, err := AppendEncodeStrict([]byte("id:"), []byte{255, 32, 167, 0, 253})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "id:zwga-e07x", string(actualResult))
+	})
+
+	t.Run("strict fails on wrong length", func(t *testing.T) {
+		actualResult, err := AppendEncodeStrict([]byte("id:"), []byte{255, 32, 167})
+
+		assert.ErrorIs(t, err, ErrInvalidLength)
+		assert.Equal(t, "id:", string(actualResult))
+	})
+
+	t.Run("without padding header", func(t *testing.T) {
+		enc := MustNewEncoding(digits, WithPaddingHeader(false))
+
+		actualResult := enc.AppendEncode([]byte("id:"), []byte{255, 32, 167, 0, 253})
+
+		assert.Equal(t, "id:zwga-e07x", string(actualResult))
+	})
+
+	t.Run("unchanged on wrong length without padding header", func(t *testing.T) {
+		enc := MustNewEncoding(digits, WithPaddingHeader(false))
+
+		assert.NotPanics(t, func() {
+			actualResult := enc.AppendEncode([]byte("id:"), []byte{255, 32, 167})
+
+			assert.Equal(t, "id:", string(actualResult))
+		})
+	})
+}
+
+func Test_AppendDecode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Dst            []byte
+			Src            string
+			Decode         func(dst, src []byte) ([]byte, error)
+			ExpectedResult []byte
+		}{
+			{
+				Name:           "nil dst",
+				Dst:            nil,
+				Src:            "4-zwga-e07x-2400-0000",
+				Decode:         AppendDecode,
+				ExpectedResult: []byte{255, 32, 167, 0, 253, 17},
+			},
+			{
+				Name:           "prefix",
+				Dst:            []byte{1, 2},
+				Src:            "4-zwga-e07x-2400-0000",
+				Decode:         AppendDecode,
+				ExpectedResult: []byte{1, 2, 255, 32, 167, 0, 253, 17},
+			},
+			{
+				Name:           "dirty capacity",
+				Dst:            []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}[:2],
+				Src:            "4-zwga-e07x-2400-0000",
+				Decode:         AppendDecode,
+				ExpectedResult: []byte{1, 2, 255, 32, 167, 0, 253, 17},
+			},
+			{
+				Name:           "strict",
+				Dst:            []byte{1, 2},
+				Src:            "zwga-e07x",
+				Decode:         AppendDecodeStrict,
+				ExpectedResult: []byte{1, 2, 255, 32, 167, 0, 253},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := tt.Decode(tt.Dst, []byte(tt.Src))
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			Src    string
+			Decode func(dst, src []byte) ([]byte, error)
+		}{
+			{
+				Name:   "invalid character",
+				Src:    "4-zwga-e07x-24u0-0000",
+				Decode: AppendDecode,
+			},
+			{
+				Name:   "invalid padding",
+				Src:    "4-",
+				Decode: AppendDecode,
+			},
+			{
+				Name:   "strict wrong length",
+				Src:    "zwga-e0",
+				Decode: AppendDecodeStrict,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := tt.Decode([]byte{1, 2}, []byte(tt.Src))
+
+				assert.Error(t, err, fmt.Sprintf("Failing value: %s", tt.Src))
+				assert.Equal(t, []byte{1, 2}, actualResult)
+			})
+		}
+	})
+}
+
+func Test_EncodeTo(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		dst := make([]byte, 32)
+
+		n, err := EncodeTo(dst, []byte{255, 32, 167, 0, 253, 17})
+		require.NoError(t, err)
+		assert.Equal(t, "4-zwga-e07x-2400-0000", string(dst[:n]))
+
+		n, err = EncodeStrictTo(dst, []byte{255, 32, 167, 0, 253})
+		require.NoError(t, err)
+		assert.Equal(t, "zwga-e07x", string(dst[:n]))
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name        string
+			Dst         []byte
+			Data        []byte
+			Encode      func(dst, src []byte) (int, error)
+			ExpectedErr error
+		}{
+			{
+				Name:        "short buffer",
+				Dst:         make([]byte, 20),
+				Data:        []byte{255, 32, 167, 0, 253, 17},
+				Encode:      EncodeTo,
+				ExpectedErr: io.ErrShortBuffer,
+			},
+			{
+				Name:        "nil input",
+				Dst:         make([]byte, 20),
+				Data:        nil,
+				Encode:      EncodeTo,
+				ExpectedErr: ErrNilInput,
+			},
+			{
+				Name:        "strict short buffer",
+				Dst:         make([]byte, 8),
+				Data:        []byte{255, 32, 167, 0, 253},
+				Encode:      EncodeStrictTo,
+				ExpectedErr: io.ErrShortBuffer,
+			},
+			{
+				Name:        "strict wrong length",
+				Dst:         make([]byte, 20),
+				Data:        []byte{255, 32, 167},
+				Encode:      EncodeStrictTo,
+				ExpectedErr: ErrInvalidLength,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				n, err := tt.Encode(tt.Dst, tt.Data)
+
+				assert.ErrorIs(t, err, tt.ExpectedErr)
+				assert.Equal(t, 0, n)
+			})
+		}
+	})
+}
+
+func Test_AppendAllocations(t *testing.T) {
+	var (
+		data    = []byte{255, 32, 167, 0, 253, 17, 215, 43, 0, 0, 1, 2, 3}
+		encoded = AppendEncode(nil, data)
+		dst     = make([]byte, 0, 64)
+	)
+
+	tests := []struct {
+		Name string
+		Func func()
+	}{
+		{
+			Name: "AppendEncode",
+			Func: func() { AppendEncode(dst, data) },
+		},
+		{
+			Name: "AppendDecode",
+			Func: func() { _, _ = AppendDecode(dst, encoded) },
+		},
+		{
+			Name: "EncodeTo",
+			Func: func() { _, _ = EncodeTo(dst[:cap(dst)], data) },
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, float64(0), testing.AllocsPerRun(100, tt.Func))
+		})
+	}
+}
+
+func Benchmark_AppendEncode_238(b *testing.B) {
+	data := make([]byte, 238)
+	dst := make([]byte, 0, EncodedLen(len(data)))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		AppendEncode(dst, data)
+	}
+}
+
+func Benchmark_AppendDecode_238(b *testing.B) {
+	encoded := AppendEncode(nil, make([]byte, 238))
+	dst := make([]byte, 0, DecodedLen(len(encoded)))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = AppendDecode(dst, encoded)
+	}
+}
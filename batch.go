@@ -0,0 +1,132 @@
+package bfh
+
+// EncodeAll encodes each of srcs into a human readable string, nil being encoded as empty data
+// The strings share a single backing buffer sized from the summed lengths.
+func EncodeAll(srcs [][]byte) []string {
+	return StdEncoding.EncodeAll(srcs)
+}
+
+// EncodeStrictAll encodes each of srcs into a strict human readable string
+// errs is nil if all of srcs are encoded, otherwise errs[i] is the error of srcs[i], which is left empty in the result.
+func EncodeStrictAll(srcs [][]byte) ([]string, []error) {
+	return StdEncoding.EncodeStrictAll(srcs)
+}
+
+// DecodeAll decodes each of strs, continuing with the rest if any of them fails
+// errs is nil if all of strs are decoded, otherwise errs[i] is the error of strs[i], which is nil in the result.
+func DecodeAll(strs []string) ([][]byte, []error) {
+	return StdEncoding.DecodeAll(strs)
+}
+
+// DecodeStrictAll decodes each of strs in strict mode, continuing with the rest if any of them fails
+func DecodeStrictAll(strs []string) ([][]byte, []error) {
+	return StdEncoding.DecodeStrictAll(strs)
+}
+
+// EncodeAll encodes each of srcs into a human readable string, nil being encoded as empty data
+func (enc *Encoding) EncodeAll(srcs [][]byte) []string {
+	if !enc.paddingHeader {
+		result, _ := enc.EncodeStrictAll(srcs)
+		return result
+	}
+
+	total := 0
+	for _, src := range srcs {
+		total += enc.EncodedLen(len(src))
+	}
+
+	buf := make([]byte, total)
+	result := make([]string, len(srcs))
+
+	for i, src := range srcs {
+		n := enc.EncodedLen(len(src))
+		enc.encodeNormal(buf[:n], src)
+
+		// buf is not modified after this point, therefore the strings can share it
+		result[i] = bytesToString(buf[:n])
+		buf = buf[n:]
+	}
+
+	return result
+}
+
+// EncodeStrictAll encodes each of srcs into a strict human readable string
+func (enc *Encoding) EncodeStrictAll(srcs [][]byte) ([]string, []error) {
+	var errs []error
+
+	total := 0
+	for i, src := range srcs {
+		if len(src)%5 != 0 {
+			errs = setError(errs, len(srcs), i, strictLengthError())
+			continue
+		}
+
+		total += enc.EncodedStrictLen(len(src))
+	}
+
+	buf := make([]byte, total)
+	result := make([]string, len(srcs))
+
+	for i, src := range srcs {
+		if errs != nil && errs[i] != nil {
+			continue
+		}
+
+		n := enc.EncodedStrictLen(len(src))
+		enc.encodeStrict(buf[:n], src)
+
+		// buf is not modified after this point, therefore the strings can share it
+		result[i] = bytesToString(buf[:n])
+		buf = buf[n:]
+	}
+
+	return result, errs
+}
+
+// DecodeAll decodes each of strs, continuing with the rest if any of them fails
+func (enc *Encoding) DecodeAll(strs []string) ([][]byte, []error) {
+	return enc.decodeAll(strs, enc.appendDecode)
+}
+
+// DecodeStrictAll decodes each of strs in strict mode, continuing with the rest if any of them fails
+func (enc *Encoding) DecodeStrictAll(strs []string) ([][]byte, []error) {
+	return enc.decodeAll(strs, enc.appendDecodeStrict)
+}
+
+// decodeAll decodes each of strs into a single backing buffer sized from the summed lengths
+func (enc *Encoding) decodeAll(strs []string, appendDecode func([]byte, string) ([]byte, error)) ([][]byte, []error) {
+	var errs []error
+
+	total := 0
+	for _, str := range strs {
+		total += enc.DecodedLen(len(str))
+	}
+
+	buf := make([]byte, 0, total)
+	result := make([][]byte, len(strs))
+
+	for i, str := range strs {
+		decoded, err := appendDecode(buf, str)
+		if err != nil {
+			errs = setError(errs, len(strs), i, err)
+			continue
+		}
+
+		// the capacity is limited, so that appending to one result does not overwrite the next one
+		result[i] = decoded[len(buf):len(decoded):len(decoded)]
+		buf = decoded
+	}
+
+	return result, errs
+}
+
+// setError sets the i-th of n errors, allocating errs on the first one
+func setError(errs []error, n, i int, err error) []error {
+	if errs == nil {
+		errs = make([]error, n)
+	}
+
+	errs[i] = err
+
+	return errs
+}
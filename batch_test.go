@@ -0,0 +1,177 @@
+package bfh
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EncodeAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		actualResult := EncodeAll([][]byte{
+			{255, 32, 167, 0, 253, 17},
+			{},
+			nil,
+			{255, 32, 167, 0, 253},
+		})
+
+		assert.Equal(t, []string{"4-zwga-e07x-2400-0000", "0-", "0-", "0-zwga-e07x"}, actualResult)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		assert.Equal(t, []string{}, EncodeAll(nil))
+	})
+
+	t.Run("strict encoding", func(t *testing.T) {
+		enc := MustNewEncoding(digits, WithPaddingHeader(false))
+
+		assert.Equal(t, []string{"zwga-e07x", ""}, enc.EncodeAll([][]byte{{255, 32, 167, 0, 253}, {1}}))
+	})
+
+	t.Run("same as EncodeStr", func(t *testing.T) {
+		srcs := make([][]byte, 100)
+		for i := range srcs {
+			srcs[i] = randomData(i)
+		}
+
+		actualResult := EncodeAll(srcs)
+
+		for i, src := range srcs {
+			expected, err := EncodeStr(src)
+			require.NoError(t, err)
+			assert.Equal(t, expected, actualResult[i])
+		}
+	})
+
+	t.Run("allocations", func(t *testing.T) {
+		srcs := [][]byte{randomData(10), randomData(10), randomData(10)}
+
+		assert.Equal(t, float64(2), testing.AllocsPerRun(100, func() { EncodeAll(srcs) }))
+	})
+}
+
+func Test_EncodeStrictAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		actualResult, errs := EncodeStrictAll([][]byte{{255, 32, 167, 0, 253}, {}})
+
+		assert.Nil(t, errs)
+		assert.Equal(t, []string{"zwga-e07x", ""}, actualResult)
+	})
+
+	t.Run("fail on some", func(t *testing.T) {
+		actualResult, errs := EncodeStrictAll([][]byte{{255, 32, 167, 0, 253}, {1}, {1, 2, 3, 4, 5}})
+
+		require.Len(t, errs, 3)
+		assert.NoError(t, errs[0])
+		assert.ErrorIs(t, errs[1], ErrInvalidLength)
+		assert.NoError(t, errs[2])
+		assert.Equal(t, []string{"zwga-e07x", "", "0410-6105"}, actualResult)
+	})
+}
+
+func Test_DecodeAll(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		actualResult, errs := DecodeAll([]string{"4-zwga-e07x-2400-0000", "0-", "0-zwga-e07x"})
+
+		assert.Nil(t, errs)
+		assert.Equal(t, [][]byte{{255, 32, 167, 0, 253, 17}, {}, {255, 32, 167, 0, 253}}, actualResult)
+	})
+
+	t.Run("fail on some", func(t *testing.T) {
+		actualResult, errs := DecodeAll([]string{"4-zwga-e07x-2400-0000", "4-zwga-e07u-2400-0000", "0-zwga-e07x", ""})
+
+		require.Len(t, errs, 4)
+		assert.NoError(t, errs[0])
+		assert.ErrorIs(t, errs[1], ErrInvalidCharacter)
+		assert.NoError(t, errs[2])
+		assert.ErrorIs(t, errs[3], ErrInvalidLength)
+		assert.Equal(t, [][]byte{{255, 32, 167, 0, 253, 17}, nil, {255, 32, 167, 0, 253}, nil}, actualResult)
+	})
+
+	t.Run("results do not overlap", func(t *testing.T) {
+		actualResult, errs := DecodeAll([]string{"0-zwga-e07x", "0-0410-6105"})
+		require.Nil(t, errs)
+
+		_ = append(actualResult[0], 9)
+
+		assert.Equal(t, []byte{1, 2, 3, 4, 5}, actualResult[1])
+	})
+
+	t.Run("allocations", func(t *testing.T) {
+		strs := []string{"4-zwga-e07x-2400-0000", "0-zwga-e07x", "0-0410-6105"}
+
+		assert.Equal(t, float64(2), testing.AllocsPerRun(100, func() { DecodeAll(strs) }))
+	})
+}
+
+func Test_DecodeStrictAll(t *testing.T) {
+	actualResult, errs := DecodeStrictAll([]string{"zwga-e07x", "zwga-e07", "0410-6105"})
+
+	require.Len(t, errs, 3)
+	assert.NoError(t, errs[0])
+	assert.ErrorIs(t, errs[1], ErrInvalidLength)
+	assert.NoError(t, errs[2])
+	assert.Equal(t, [][]byte{{255, 32, 167, 0, 253}, nil, {1, 2, 3, 4, 5}}, actualResult)
+}
+
+// batchSize is the number of 10-byte IDs encoded and decoded by the batch benchmarks
+const batchSize = 1000
+
+func batchData() ([][]byte, []string) {
+	srcs := make([][]byte, batchSize)
+	strs := make([]string, batchSize)
+
+	for i := range srcs {
+		srcs[i] = randomData(10 + i%10)
+		strs[i], _ = EncodeStr(srcs[i])
+	}
+
+	return srcs, strs
+}
+
+func Benchmark_EncodeAll(b *testing.B) {
+	srcs, _ := batchData()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		EncodeAll(srcs)
+	}
+}
+
+func Benchmark_EncodeStr_Loop(b *testing.B) {
+	srcs, _ := batchData()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result := make([]string, len(srcs))
+		for j, src := range srcs {
+			result[j], _ = EncodeStr(src)
+		}
+	}
+}
+
+func Benchmark_DecodeAll(b *testing.B) {
+	_, strs := batchData()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DecodeAll(strs)
+	}
+}
+
+func Benchmark_DecodeStr_Loop(b *testing.B) {
+	_, strs := batchData()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		result := make([][]byte, len(strs))
+		for j, str := range strs {
+			result[j], _ = DecodeStr(str)
+		}
+	}
+}
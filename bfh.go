@@ -1,107 +1,22 @@
 package bfh
 
 import (
-	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
 )
 
 const (
 	digits                         = "0123456789abcdefghjkmnpqrstvwxyz"
-	errMsgBinaryDataMustNotBeNil   = "binary data must not be nil"
 	errMsgStrictMustBeDividableBy5 = "length of binary data must be some multiple of 5 for strict encoding"
-	errMsgStrictMustBeDividableBy8 = "length of encoded string must be some multiple of 8 for strict decoding"
-	errMsgStrictInvalid            = "invalid encoded string for strict mode"
-	errMsgPaddingNotBetween0and4   = "non empty string must start with 0, 1, 2, 3 or 4"
-	errMsgContainsInvalidCharacter = "string contains invalid character: %s"
 
 	// note that valid encoded strings will not end in a hyphen, it needs to be added when validating
 	separator = '-'
 )
 
-var (
-	encodeMasks = []uint8{0xff, 0x7f, 0x3f, 0x1f, 0x0f, 0x07, 0x03, 0x01}
-	decodeMasks = []uint8{0x1, 0x3, 0x7, 0xf}
-)
-
-// nolint: gocyclo
-func getDigit(r uint8) (byte, error) {
-	switch r {
-	case '0':
-		return 0, nil
-	case '1':
-		return 1, nil
-	case '2':
-		return 2, nil
-	case '3':
-		return 3, nil
-	case '4':
-		return 4, nil
-	case '5':
-		return 5, nil
-	case '6':
-		return 6, nil
-	case '7':
-		return 7, nil
-	case '8':
-		return 8, nil
-	case '9':
-		return 9, nil
-	case 'a':
-		return 10, nil
-	case 'b':
-		return 11, nil
-	case 'c':
-		return 12, nil
-	case 'd':
-		return 13, nil
-	case 'e':
-		return 14, nil
-	case 'f':
-		return 15, nil
-	case 'g':
-		return 16, nil
-	case 'h':
-		return 17, nil
-	case 'j':
-		return 18, nil
-	case 'k':
-		return 19, nil
-	case 'm':
-		return 20, nil
-	case 'n':
-		return 21, nil
-	case 'p':
-		return 22, nil
-	case 'q':
-		return 23, nil
-	case 'r':
-		return 24, nil
-	case 's':
-		return 25, nil
-	case 't':
-		return 26, nil
-	case 'v':
-		return 27, nil
-	case 'w':
-		return 28, nil
-	case 'x':
-		return 29, nil
-	case 'y':
-		return 30, nil
-	case 'z':
-		return 31, nil
-	}
-
-	return 0, errors.New(errMsgContainsInvalidCharacter)
-}
-
 // RemoveByte is used instead of strings.Replace because it is much faster
 func RemoveByte(str string, ch byte) string {
-	dashCount := 0
-	for i := 0; i < len(str); i++ {
-		if str[i] == ch {
-			dashCount++
-		}
-	}
+	dashCount := countByte(str, ch)
 
 	if dashCount == 0 {
 		return str
@@ -122,195 +37,215 @@ func RemoveByte(str string, ch byte) string {
 	return string(b)
 }
 
-// Encode encodes binary data into a human readable text
-func Encode(b []byte) ([]byte, error) {
-	if b == nil {
-		return nil, errors.New(errMsgBinaryDataMustNotBeNil)
+// countByte returns the number of times ch is found in str
+func countByte(str string, ch byte) int {
+	// strings.Count is vectorized, but it counts runes, not bytes
+	if ch < utf8.RuneSelf {
+		return strings.Count(str, string(rune(ch)))
+	}
+
+	count := 0
+	for i := 0; i < len(str); i++ {
+		if str[i] == ch {
+			count++
+		}
 	}
 
-	result := newNormalResult(len(b))
+	return count
+}
 
-	result = encode(b, result, 2)
+// strictLengthError is returned when data of the wrong length is to be encoded in strict mode
+func strictLengthError() error {
+	return fmt.Errorf("%w: %s", ErrInvalidLength, errMsgStrictMustBeDividableBy5)
+}
 
-	return result, nil
+// Encode encodes binary data into a human readable text
+func Encode(b []byte) ([]byte, error) {
+	return StdEncoding.Encode(b)
 }
 
 // EncodeStr encodes binary data into a human readable string
 func EncodeStr(b []byte) (string, error) {
-	result, err := Encode(b)
+	return StdEncoding.EncodeStr(b)
+}
+
+// EncodeStrict encodes binary data with a length dividable by 5 into a simplified human readable text
+func EncodeStrict(b []byte) ([]byte, error) {
+	return StdEncoding.EncodeStrict(b)
+}
+
+// EncodeStrictStr encodes binary data into a human readable string
+func EncodeStrictStr(b []byte) (string, error) {
+	return StdEncoding.EncodeStrictStr(b)
+}
+
+// Encode encodes binary data into a human readable text
+func (enc *Encoding) Encode(b []byte) ([]byte, error) {
+	if !enc.paddingHeader {
+		return enc.EncodeStrict(b)
+	}
+
+	if b == nil {
+		return nil, ErrNilInput
+	}
+
+	return enc.AppendEncode(make([]byte, 0, enc.EncodedLen(len(b))), b), nil
+}
+
+// EncodeStr encodes binary data into a human readable string
+func (enc *Encoding) EncodeStr(b []byte) (string, error) {
+	result, err := enc.Encode(b)
 	if err != nil {
 		return "", err
 	}
 
-	return string(result), nil
+	// result is not referenced anywhere else, therefore there is no need to copy it
+	return bytesToString(result), nil
 }
 
 // EncodeStrict encodes binary data with a length dividable by 5 into a simplified human readable text
-func EncodeStrict(b []byte) ([]byte, error) {
+func (enc *Encoding) EncodeStrict(b []byte) ([]byte, error) {
 	if b == nil {
-		return nil, errors.New(errMsgBinaryDataMustNotBeNil)
+		return nil, ErrNilInput
 	}
 
 	if len(b)%5 != 0 {
-		return nil, errors.New(errMsgStrictMustBeDividableBy5)
+		return nil, strictLengthError()
 	}
 
-	result := newStrictEncodeResult(len(b))
-
-	result = encode(b, result, 0)
+	result := make([]byte, enc.EncodedStrictLen(len(b)))
+	enc.encodeStrict(result, b)
 
 	return result, nil
 }
 
 // EncodeStrictStr encodes binary data into a human readable string
-func EncodeStrictStr(b []byte) (string, error) {
-	result, err := EncodeStrict(b)
+func (enc *Encoding) EncodeStrictStr(b []byte) (string, error) {
+	result, err := enc.EncodeStrict(b)
 	if err != nil {
 		return "", err
 	}
 
-	return string(result), nil
+	// result is not referenced anywhere else, therefore there is no need to copy it
+	return bytesToString(result), nil
 }
 
-// newNormalResult will create a byte slice and fill it with dashes and zeros as required, plus setting the padding byte
-func newNormalResult(byteLength int) []byte {
-	b32padding := (5 - byteLength%5) % 5
-	b32Length := (byteLength+b32padding)*2 + 1
+// encodeNormal writes the normal mode encoding of b into result, which must be exactly EncodedLen(len(b)) long
+func (enc *Encoding) encodeNormal(result, b []byte) {
+	offset := enc.headerLength()
 
-	if byteLength == 0 {
-		b32Length++
+	result[0] = enc.alphabet[(5-len(b)%5)%5]
+	if offset > 1 {
+		result[1] = enc.separator
 	}
 
-	s1 := make([]byte, b32Length)
-	for i := 0; i < b32Length; i++ {
-		s1[i] = byte('0')
-	}
-	for i := 6; i < b32Length; i += 5 {
-		s1[i] = byte(separator)
-	}
-
-	s1[0] = byte(b32padding + '0')
-	s1[1] = byte(separator)
-
-	return s1
+	enc.encode(b, result, offset)
 }
 
-// newStrictEncodeResult will create a byte slice and fill it with dashes and zeros as required
-func newStrictEncodeResult(byteLength int) []byte {
-	b32Length := (byteLength)*2 - 1
-
-	if byteLength == 0 {
-		b32Length++
-	}
-
-	s1 := make([]byte, b32Length)
-	for i := 0; i < b32Length; i++ {
-		s1[i] = byte('0')
-	}
-	for i := 4; i < b32Length; i += 5 {
-		s1[i] = byte(separator)
-	}
-
-	return s1
+// encodeStrict writes the strict mode encoding of b into result, which must be exactly EncodedStrictLen(len(b)) long
+func (enc *Encoding) encodeStrict(result, b []byte) {
+	enc.encode(b, result, 0)
 }
 
-func encode(b, result []byte, offset int) []byte {
+// encode writes the digits representing b into result starting at offset, placing the separators as well
+// Data is read 5 bytes at a time, which are represented by 8 digits. A missing part of the last packet is zero filled.
+func (enc *Encoding) encode(b, result []byte, offset int) {
 	var (
-		readCount = 0
-		maxCount  = len(b)*8/5 + 1
-		f         byte
-		idx       int
+		pos    = offset
+		column = 0
+		last   [packetLength]byte
 	)
 
-	for maxCount > readCount {
-		f = readByte(b, readCount*5)
-
-		idx = readCount + offset + (readCount / 4)
-		if idx >= len(result) {
-			break
+	for i := 0; i < len(b); i += packetLength {
+		packet := b[i:]
+		if len(packet) < packetLength {
+			copy(last[:], packet)
+			packet = last[:]
 		}
 
-		result[idx] = digits[f]
+		word := packetWord(packet)
+
+		switch enc.groupLength {
+		case 0:
+			enc.encodeHalf(result[pos:pos+4], word>>20)
+			enc.encodeHalf(result[pos+4:pos+8], word)
+			pos += 8
+		case 4:
+			if pos > offset {
+				result[pos] = enc.separator
+				pos++
+			}
 
-		readCount++
+			enc.encodeHalf(result[pos:pos+4], word>>20)
+			result[pos+4] = enc.separator
+			enc.encodeHalf(result[pos+5:pos+9], word)
+			pos += 9
+		default:
+			for digit := 0; digit < packetDigits; digit++ {
+				if column == enc.groupLength {
+					result[pos] = enc.separator
+					pos++
+					column = 0
+				}
+
+				result[pos] = enc.alphabet[word>>uint(35-5*digit)&0x1f]
+				pos++
+				column++
+			}
+		}
 	}
-
-	return result
 }
 
-func readByte(b []byte, readBits int) byte {
-	m := uint8(readBits % 8)
-	l := readBits / 8
-
-	// we need to mask out bits we've read before
-	if l >= len(b) {
-		return 0
-	}
-	f := b[l] & encodeMasks[m]
-
-	// if we're reading from the first half of the byte than we have it easy...
-	// otherwise we need to push some bits towards larger value to leave place
-	// for bits from the next byte
-	if m < 4 {
-		return f >> (3 - m)
-	}
+// encodeHalf writes the 4 digits representing the lowest 20 bits of word into result
+func (enc *Encoding) encodeHalf(result []byte, word uint64) {
+	_ = result[3]
 
-	f <<= m - 3
+	result[0] = enc.alphabet[word>>15&0x1f]
+	result[1] = enc.alphabet[word>>10&0x1f]
+	result[2] = enc.alphabet[word>>5&0x1f]
+	result[3] = enc.alphabet[word&0x1f]
+}
 
-	// next byte may not exist
-	// otherwise we'll need push the first bits to the end
-	var s byte
-	if len(b) > l+1 {
-		s = b[l+1] >> (11 - m)
-	}
+// packetWord returns the first 5 bytes of packet as a 40-bit big-endian number
+func packetWord(packet []byte) uint64 {
+	_ = packet[4]
 
-	return f | s
+	return uint64(packet[0])<<32 | uint64(packet[1])<<24 | uint64(packet[2])<<16 | uint64(packet[3])<<8 | uint64(packet[4])
 }
 
 // Decode decodes some binary data from a human readable text
 func Decode(b []byte) ([]byte, error) {
-	data, err := DecodeStr(string(b))
-	if err != nil {
-		return nil, err
-	}
-
-	return data, nil
+	return StdEncoding.Decode(b)
 }
 
 // DecodeStr decodes some binary data from a human readable string
 func DecodeStr(str string) ([]byte, error) {
-	// dashes are not needed, they only help readability
-	str = RemoveByte(str, separator)
-
-	padding, err := getDigit(str[0])
-	if err != nil {
-		return nil, err
-	}
-	if padding > 4 {
-		return nil, errors.New(errMsgPaddingNotBetween0and4)
-	}
+	return StdEncoding.DecodeStr(str)
+}
 
-	str = str[1:]
+// DecodeStrict decodes some binary data from a human readable text without using any padding
+func DecodeStrict(b []byte) ([]byte, error) {
+	return StdEncoding.DecodeStrict(b)
+}
 
-	if len(str)%8 != 0 {
-		return nil, errors.New(errMsgStrictMustBeDividableBy8)
-	}
+// DecodeStrictStr decodes some binary data from a human readable string without using any padding
+func DecodeStrictStr(str string) ([]byte, error) {
+	return StdEncoding.DecodeStrictStr(str)
+}
 
-	data, err := decode(str)
+// Decode decodes some binary data from a human readable text
+func (enc *Encoding) Decode(b []byte) ([]byte, error) {
+	data, err := enc.AppendDecode(make([]byte, 0, enc.DecodedLen(len(b))), b)
 	if err != nil {
 		return nil, err
 	}
 
-	if padding > 0 {
-		return data[:len(data)-int(padding)], nil
-	}
-
 	return data, nil
 }
 
-// DecodeStrict decodes some binary data from a human readable text without using any padding
-func DecodeStrict(b []byte) ([]byte, error) {
-	data, err := DecodeStrictStr(string(b))
+// DecodeStr decodes some binary data from a human readable string
+func (enc *Encoding) DecodeStr(str string) ([]byte, error) {
+	data, err := enc.appendDecode(make([]byte, 0, enc.DecodedLen(len(str))), str)
 	if err != nil {
 		return nil, err
 	}
@@ -318,246 +253,238 @@ func DecodeStrict(b []byte) ([]byte, error) {
 	return data, nil
 }
 
-// DecodeStrictStr decodes some binary data from a human readable string without using any padding
-func DecodeStrictStr(str string) ([]byte, error) {
-	// dashes are not needed, they only help readability
-	str = RemoveByte(str, separator)
-
-	if len(str)%8 != 0 {
-		return nil, errors.New(errMsgStrictInvalid)
+// appendDecode appends the data decoded from str to dst, dst is returned unchanged on failure
+func (enc *Encoding) appendDecode(dst []byte, str string) ([]byte, error) {
+	if !enc.paddingHeader {
+		return enc.appendDecodeStrict(dst, str)
 	}
 
-	return decode(str)
+	return enc.appendDecodeNormal(dst, str)
 }
 
-func decode(str string) ([]byte, error) {
-	// string length -> byte length:
-	// - len(str)-1 as base since first byte represents the padding
-	// - *5/8 as 1 byte represents 5 bits and 1 byte is 8 bits of course
-	// - -padding to get the original length
-	data := make([]byte, len(str)*5/8)
+// appendDecodeNormal appends the data decoded from str starting with a padding digit to dst, dst is returned unchanged
+// on failure
+func (enc *Encoding) appendDecodeNormal(dst []byte, str string) ([]byte, error) {
+	first, padding, digitCount, err := enc.normalHeader(str)
+	if err != nil {
+		return dst, err
+	}
 
-	for i := 0; i < len(str); i++ {
-		charValue, err := getDigit(str[i])
-		if err != nil {
-			return nil, err
-		}
+	result, err := enc.decode(dst, str, first+1, digitCount)
+	if err != nil {
+		return dst, err
+	}
 
-		byteIndex := i * 5 / 8
+	return enc.removePadding(dst, result, str, first, padding)
+}
 
-		firstByte, secondByte := splitByte(charValue, i)
+// normalHeader checks the padding digit and the length of str starting with a padding digit, returning the index of
+// the padding digit, the padding and the number of digits following it
+func (enc *Encoding) normalHeader(str string) (int, int, int, error) {
+	// separators are not needed, they only help readability
+	digitCount := len(str) - countByte(str, enc.separator)
+	if digitCount == 0 {
+		return 0, 0, 0, newCorruptInputError(str, len(str), ErrInvalidLength)
+	}
 
-		data[byteIndex] |= firstByte
+	first := enc.skipSeparators(str, 0)
 
-		if secondByte > 0 {
-			data[byteIndex+1] |= secondByte
-		}
+	padding, err := enc.getDigit(str[first])
+	if err != nil {
+		return 0, 0, 0, newCorruptInputError(str, first, err)
+	}
+	if padding > 4 {
+		return 0, 0, 0, newCorruptInputError(str, first, ErrInvalidPadding)
 	}
 
-	return data, nil
+	if (digitCount-1)%8 != 0 {
+		return 0, 0, 0, newCorruptInputError(str, len(str), ErrInvalidLength)
+	}
+
+	return first, int(padding), digitCount - 1, nil
 }
 
-func splitByte(charValue uint8, stringIndex int) (byte, byte) {
-	mod := uint8((stringIndex * 5) % 8)
+// removePadding removes the padding bytes from the end of result, the data decoded from str appended to dst, checking
+// that they are zeros if the encoding is canonical
+func (enc *Encoding) removePadding(dst, result []byte, str string, first, padding int) ([]byte, error) {
+	if padding > len(result)-len(dst) {
+		return dst, newCorruptInputError(str, first, ErrInvalidPadding)
+	}
+
+	if enc.canonical && !isZero(result[len(result)-padding:]) {
+		offset, err := enc.validatePadding(str, padding, (len(result)-len(dst))/5*8)
 
-	if mod < 4 {
-		return charValue << (3 - mod), 0
+		return dst, newCorruptInputError(str, offset, err)
 	}
 
-	return charValue >> (mod - 3), charValue & decodeMasks[mod-4] << (11 - mod)
+	return result[:len(result)-padding], nil
 }
 
-// IsWellFormatted returns true if the string is a well-formatted string
-func IsWellFormatted(str string) bool {
-	if len(str) < 2 {
-		return false
+// DecodeStrict decodes some binary data from a human readable text without using any padding
+func (enc *Encoding) DecodeStrict(b []byte) ([]byte, error) {
+	data, err := enc.AppendDecodeStrict(make([]byte, 0, enc.DecodedLen(len(b))), b)
+	if err != nil {
+		return nil, err
 	}
 
-	ch, err := getDigit(str[0])
-	if err != nil || ch > 4 {
-		return false
-	}
+	return data, nil
+}
 
-	if !IsStrict(str[2:]) {
-		return false
+// DecodeStrictStr decodes some binary data from a human readable string without using any padding
+func (enc *Encoding) DecodeStrictStr(str string) ([]byte, error) {
+	data, err := enc.appendDecodeStrict(make([]byte, 0, enc.DecodedLen(len(str))), str)
+	if err != nil {
+		return nil, err
 	}
 
-	str = RemoveByte(str, separator)
-
-	return isValidEnding(len(str), str)
+	return data, nil
 }
 
-// IsAcceptable returns true if bfh can accept it for decoding
-func IsAcceptable(str string) bool {
-	fixedStr := RemoveByte(str, separator)
-
-	if len(str) == 0 {
-		return false
-	}
+// appendDecodeStrict appends the data decoded from the strict mode str to dst, dst is returned unchanged on failure
+func (enc *Encoding) appendDecodeStrict(dst []byte, str string) ([]byte, error) {
+	// separators are not needed, they only help readability
+	digitCount := len(str) - countByte(str, enc.separator)
 
-	firstCh, err := getDigit(str[0])
-	if err != nil || firstCh > 4 {
-		return false
+	if digitCount%8 != 0 {
+		return dst, newCorruptInputError(str, len(str), ErrInvalidLength)
 	}
 
-	if !validDigitsOnly(fixedStr) {
-		return false
+	result, err := enc.decode(dst, str, 0, digitCount)
+	if err != nil {
+		return dst, err
 	}
 
-	return isValidEnding(len(fixedStr), fixedStr)
+	return result, nil
 }
 
-func validDigitsOnly(str string) bool {
-	for i := 0; i < len(str); i++ {
-		_, err := getDigit(str[i])
-		if err != nil {
-			return false
+// decode appends the data represented by digitCount digits of str starting at offset to dst, skipping separators
+// digitCount must be some multiple of 8, as digits are decoded in blocks of 8 digits representing 5 bytes. Blocks of
+// the default layouts, 4+4 digits with a separator in between and 8 digits without any, are decoded in one go.
+func (enc *Encoding) decode(dst []byte, str string, offset, digitCount int) ([]byte, error) {
+	// string length -> byte length:
+	// - *5/8 as 1 byte represents 5 bits and 1 byte is 8 bits of course
+	dst, data := grow(dst, digitCount*5/8)
+
+	i := offset
+	for out := 0; out < len(data); out += 5 {
+		i = enc.skipSeparators(str, i)
+
+		switch {
+		case i+9 <= len(str) && str[i+4] == enc.separator && enc.decodeBlock(data[out:out+5], str[i:i+4], str[i+5:i+9]):
+			i += 9
+		case i+8 <= len(str) && enc.decodeBlock(data[out:out+5], str[i:i+4], str[i+4:i+8]):
+			i += 8
+		default:
+			var err error
+			if i, err = enc.decodeScattered(data[out:out+5], str, i); err != nil {
+				return dst, err
+			}
 		}
 	}
 
-	return true
+	return dst, nil
 }
 
-func isValidEnding(length int, str string) bool {
-	if length == 1 && str == "0" {
-		return true
-	}
-
-	if length < 9 {
+// decodeBlock decodes the 8 digits of two halves of a block into 5 bytes, returning false if any of them is invalid
+func (enc *Encoding) decodeBlock(data []byte, first, second string) bool {
+	var values [packetDigits]byte
+
+	values[0] = enc.decodeMap[first[0]]
+	values[1] = enc.decodeMap[first[1]]
+	values[2] = enc.decodeMap[first[2]]
+	values[3] = enc.decodeMap[first[3]]
+	values[4] = enc.decodeMap[second[0]]
+	values[5] = enc.decodeMap[second[1]]
+	values[6] = enc.decodeMap[second[2]]
+	values[7] = enc.decodeMap[second[3]]
+
+	// valid digits only use the lowest 5 bits, while invalidDigit has the highest ones set
+	if (values[0]|values[1]|values[2]|values[3]|values[4]|values[5]|values[6]|values[7])&^0x1f != 0 {
 		return false
 	}
 
-	switch str[0:1] {
-	case "1":
-		return isValidEndingPadding1(length, str)
-	case "2":
-		return isValidEndingPadding2(length, str)
-	case "3":
-		return isValidEndingPadding3(length, str)
-	case "4":
-		return isValidEndingPadding4(length, str)
-	}
+	decodeValues(data, &values)
 
 	return true
 }
 
-func isValidEndingPadding1(length int, str string) bool {
-	if str[length-1:] != "0" {
-		return false
-	}
+// decodeScattered decodes the next 8 digits of str starting at offset, skipping separators wherever they are
+// It returns the index following the last digit, or the error describing the first invalid character.
+func (enc *Encoding) decodeScattered(data []byte, str string, offset int) (int, error) {
+	var values [packetDigits]byte
 
-	switch str[length-2] {
-	case '0':
-		return true
-	case '8':
-		return true
-	case 'g':
-		return true
-	case 'r':
-		return true
-	}
+	i := offset
+	for n := 0; n < len(values); i++ {
+		if str[i] == enc.separator {
+			continue
+		}
 
-	return false
-}
+		value, err := enc.getDigit(str[i])
+		if err != nil {
+			return i, newCorruptInputError(str, i, err)
+		}
 
-// nolint: gocyclo
-func isValidEndingPadding2(length int, str string) bool {
-	if str[length-3:] != "000" {
-		return false
+		values[n] = value
+		n++
 	}
 
-	switch str[length-4] {
-	case '0':
-		return true
-	case '2':
-		return true
-	case '4':
-		return true
-	case '6':
-		return true
-	case '8':
-		return true
-	case 'a':
-		return true
-	case 'c':
-		return true
-	case 'e':
-		return true
-	case 'g':
-		return true
-	case 'j':
-		return true
-	case 'm':
-		return true
-	case 'p':
-		return true
-	case 'r':
-		return true
-	case 't':
-		return true
-	case 'w':
-		return true
-	case 'y':
-		return true
-	}
+	decodeValues(data, &values)
 
-	return false
+	return i, nil
 }
 
-func isValidEndingPadding3(length int, str string) bool {
-	if str[length-4:] != "0000" {
-		return false
+// skipSeparators returns the index of the first non-separator character of str starting at offset
+func (enc *Encoding) skipSeparators(str string, offset int) int {
+	for offset < len(str) && str[offset] == enc.separator {
+		offset++
 	}
 
-	return str[length-5] == '0' || str[length-5] == 'g'
+	return offset
 }
 
-func isValidEndingPadding4(length int, str string) bool {
-	if str[length-6:] != "000000" {
-		return false
-	}
+// decodeValues writes the 40 bits represented by 8 digit values into the first 5 bytes of data
+func decodeValues(data []byte, values *[packetDigits]byte) {
+	_ = data[4]
 
-	switch str[length-7] {
-	case '0':
-		return true
-	case '4':
-		return true
-	case '8':
-		return true
-	case 'c':
-		return true
-	case 'g':
-		return true
-	case 'm':
-		return true
-	case 'r':
-		return true
-	case 'w':
-		return true
-	}
+	data[0] = values[0]<<3 | values[1]>>2
+	data[1] = values[1]<<6 | values[2]<<1 | values[3]>>4
+	data[2] = values[3]<<4 | values[4]>>1
+	data[3] = values[4]<<7 | values[5]<<2 | values[6]>>3
+	data[4] = values[6]<<5 | values[7]
+}
 
-	return false
+// IsWellFormatted returns true if the string is a well-formatted string
+func IsWellFormatted(str string) bool {
+	return StdEncoding.IsWellFormatted(str)
+}
+
+// IsAcceptable returns true if bfh can accept it for decoding
+func IsAcceptable(str string) bool {
+	return StdEncoding.IsAcceptable(str)
 }
 
 // IsStrict returns true if the string is strict-compatible
 func IsStrict(str string) bool {
-	if len(str) > 0 && len(str)%5 != 4 {
-		return false
-	}
+	return StdEncoding.IsStrict(str)
+}
 
-	for i := 0; i < len(str); i++ {
-		if i%5 == 4 {
-			if str[i] != separator {
-				return false
-			}
-			continue
-		}
+// IsWellFormatted returns true if the string is a well-formatted string
+func (enc *Encoding) IsWellFormatted(str string) bool {
+	_, err := enc.validateWellFormatted(str)
 
-		_, err := getDigit(str[i])
-		if err != nil {
-			return false
-		}
-	}
+	return err == nil
+}
 
-	return true
+// IsAcceptable returns true if bfh can accept it for decoding
+func (enc *Encoding) IsAcceptable(str string) bool {
+	_, err := enc.validate(str)
+
+	return err == nil
+}
+
+// IsStrict returns true if the string is strict-compatible
+func (enc *Encoding) IsStrict(str string) bool {
+	_, err := enc.validateStrict(str)
+
+	return err == nil
 }
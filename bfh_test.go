@@ -2,6 +2,7 @@ package bfh
 
 import (
 	"crypto/rand"
+	"encoding/base32"
 	"fmt"
 	"testing"
 
@@ -1430,6 +1431,46 @@ func Benchmark_EncodeStrictStr_240(b *testing.B) {
 	encodeResult = str
 }
 
+func Benchmark_Base32EncodeToString_23(b *testing.B) {
+	var str string
+
+	for n := 0; n < b.N; n++ {
+		str = base32.StdEncoding.EncodeToString(data[:23])
+	}
+
+	encodeResult = str
+}
+
+func Benchmark_Base32EncodeToString_25(b *testing.B) {
+	var str string
+
+	for n := 0; n < b.N; n++ {
+		str = base32.StdEncoding.EncodeToString(data[:25])
+	}
+
+	encodeResult = str
+}
+
+func Benchmark_Base32EncodeToString_238(b *testing.B) {
+	var str string
+
+	for n := 0; n < b.N; n++ {
+		str = base32.StdEncoding.EncodeToString(data[:238])
+	}
+
+	encodeResult = str
+}
+
+func Benchmark_Base32EncodeToString_240(b *testing.B) {
+	var str string
+
+	for n := 0; n < b.N; n++ {
+		str = base32.StdEncoding.EncodeToString(data[:240])
+	}
+
+	encodeResult = str
+}
+
 func Benchmark_DecodeStr_23(b *testing.B) {
 	var decodedData []byte
 
@@ -1470,6 +1511,58 @@ func Benchmark_DecodeStrictStr_240(b *testing.B) {
 	decodedResult = decodedData
 }
 
+func Benchmark_Base32DecodeString_23(b *testing.B) {
+	var decodedData []byte
+
+	str := base32.StdEncoding.EncodeToString(data[:23])
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		decodedData, _ = base32.StdEncoding.DecodeString(str)
+	}
+
+	decodedResult = decodedData
+}
+
+func Benchmark_Base32DecodeString_25(b *testing.B) {
+	var decodedData []byte
+
+	str := base32.StdEncoding.EncodeToString(data[:25])
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		decodedData, _ = base32.StdEncoding.DecodeString(str)
+	}
+
+	decodedResult = decodedData
+}
+
+func Benchmark_Base32DecodeString_238(b *testing.B) {
+	var decodedData []byte
+
+	str := base32.StdEncoding.EncodeToString(data[:238])
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		decodedData, _ = base32.StdEncoding.DecodeString(str)
+	}
+
+	decodedResult = decodedData
+}
+
+func Benchmark_Base32DecodeString_240(b *testing.B) {
+	var decodedData []byte
+
+	str := base32.StdEncoding.EncodeToString(data[:240])
+	b.ResetTimer()
+
+	for n := 0; n < b.N; n++ {
+		decodedData, _ = base32.StdEncoding.DecodeString(str)
+	}
+
+	decodedResult = decodedData
+}
+
 func Benchmark_IsWellFormattedBfh_238(b *testing.B) {
 	var validatedData bool
 
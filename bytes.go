@@ -0,0 +1,258 @@
+package bfh
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"strconv"
+)
+
+// ungroupedEncoding is used for formatting Bytes with the # flag
+var ungroupedEncoding = MustNewEncoding(digits, WithGroupLength(0), WithCanonical(false))
+
+var (
+	_ encoding.TextMarshaler   = Bytes(nil)
+	_ encoding.TextUnmarshaler = (*Bytes)(nil)
+	_ json.Marshaler           = Bytes(nil)
+	_ json.Unmarshaler         = (*Bytes)(nil)
+	_ sql.Scanner              = (*Bytes)(nil)
+	_ driver.Valuer            = Bytes(nil)
+	_ fmt.Stringer             = Bytes(nil)
+	_ fmt.Formatter            = Bytes(nil)
+	_ flag.Value               = (*Bytes)(nil)
+
+	_ encoding.TextMarshaler   = RawBytes(nil)
+	_ encoding.TextUnmarshaler = (*RawBytes)(nil)
+	_ json.Marshaler           = RawBytes(nil)
+	_ json.Unmarshaler         = (*RawBytes)(nil)
+	_ sql.Scanner              = (*RawBytes)(nil)
+	_ driver.Valuer            = RawBytes(nil)
+	_ fmt.Stringer             = RawBytes(nil)
+	_ fmt.Formatter            = RawBytes(nil)
+	_ flag.Value               = (*RawBytes)(nil)
+)
+
+// Bytes is binary data represented by its normal mode encoded string in text, JSON, databases and command line flags
+// A nil Bytes is encoded as empty data, except for JSON and databases, where it is null.
+type Bytes []byte
+
+// String returns the normal mode encoded string
+func (b Bytes) String() string {
+	str, _ := EncodeStr(b.nonNil())
+
+	return str
+}
+
+// Format implements fmt.Formatter
+// %s and %v write the normal mode encoded string, the + flag selects strict mode, the # flag removes the separators.
+// %q writes the same string quoted, %x and %X write the bytes as hexadecimal numbers. Width, precision and the - flag
+// work as they do for strings.
+func (b Bytes) Format(f fmt.State, verb rune) {
+	switch verb {
+	case 's', 'v', 'q':
+		enc := StdEncoding
+		if f.Flag('#') {
+			enc = ungroupedEncoding
+		}
+
+		encode := enc.EncodeStr
+		if f.Flag('+') {
+			encode = enc.EncodeStrictStr
+		}
+
+		str, err := encode(b.nonNil())
+		if err != nil {
+			fmt.Fprintf(f, "%%!%c(bfh.Bytes=%v)", verb, err)
+			return
+		}
+
+		// the + and # flags are already taken care of, they must not change the quoting
+		if verb != 'q' {
+			verb = 's'
+		}
+
+		fmt.Fprintf(f, formatVerb(f, verb, "-"), str)
+	case 'x', 'X':
+		fmt.Fprintf(f, formatVerb(f, verb, "-# 0"), []byte(b))
+	default:
+		fmt.Fprintf(f, "%%!%c(bfh.Bytes=%s)", verb, b.String())
+	}
+}
+
+// MarshalText returns the normal mode encoded string
+func (b Bytes) MarshalText() ([]byte, error) {
+	return Encode(b.nonNil())
+}
+
+// UnmarshalText decodes a normal mode encoded string, an empty text results in nil
+func (b *Bytes) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*b = nil
+		return nil
+	}
+
+	data, err := Decode(text)
+	if err != nil {
+		return err
+	}
+
+	*b = data
+
+	return nil
+}
+
+// MarshalJSON returns the normal mode encoded string as a JSON string, or null for nil
+func (b Bytes) MarshalJSON() ([]byte, error) {
+	if b == nil {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(b.String())
+}
+
+// UnmarshalJSON decodes a JSON string holding a normal mode encoded string, null results in nil
+func (b *Bytes) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*b = nil
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		return err
+	}
+
+	return b.UnmarshalText([]byte(str))
+}
+
+// Value returns the normal mode encoded string stored in databases, or nil for nil
+func (b Bytes) Value() (driver.Value, error) {
+	if b == nil {
+		return nil, nil
+	}
+
+	return b.String(), nil
+}
+
+// Scan decodes a normal mode encoded string read from databases, given either as a string or as bytes
+func (b *Bytes) Scan(src interface{}) error {
+	return b.scan(src, false)
+}
+
+// Set decodes a normal mode encoded string given as a command line flag
+func (b *Bytes) Set(str string) error {
+	return b.UnmarshalText([]byte(str))
+}
+
+// scan reads a value stored in databases, strings are always decoded while bytes are only decoded unless raw
+func (b *Bytes) scan(src interface{}, raw bool) error {
+	switch v := src.(type) {
+	case nil:
+		*b = nil
+	case string:
+		return b.UnmarshalText([]byte(v))
+	case []byte:
+		if raw {
+			// the driver may reuse its buffer
+			*b = append(Bytes{}, v...)
+			return nil
+		}
+
+		return b.UnmarshalText(v)
+	default:
+		typeName := "Bytes"
+		if raw {
+			typeName = "RawBytes"
+		}
+
+		return fmt.Errorf("bfh: can not scan %T into %s", src, typeName)
+	}
+
+	return nil
+}
+
+// nonNil returns b as a non-nil byte slice, as nil data can not be encoded
+func (b Bytes) nonNil() []byte {
+	if b == nil {
+		return []byte{}
+	}
+
+	return b
+}
+
+// RawBytes is the same as Bytes, except that databases store the bytes themselves, e.g. in a bytea or blob column
+// Encoded strings are still accepted when scanning values.
+type RawBytes []byte
+
+// String returns the normal mode encoded string
+func (r RawBytes) String() string {
+	return Bytes(r).String()
+}
+
+// Format implements fmt.Formatter the same way as Bytes does
+func (r RawBytes) Format(f fmt.State, verb rune) {
+	Bytes(r).Format(f, verb)
+}
+
+// MarshalText returns the normal mode encoded string
+func (r RawBytes) MarshalText() ([]byte, error) {
+	return Bytes(r).MarshalText()
+}
+
+// UnmarshalText decodes a normal mode encoded string, an empty text results in nil
+func (r *RawBytes) UnmarshalText(text []byte) error {
+	return (*Bytes)(r).UnmarshalText(text)
+}
+
+// MarshalJSON returns the normal mode encoded string as a JSON string, or null for nil
+func (r RawBytes) MarshalJSON() ([]byte, error) {
+	return Bytes(r).MarshalJSON()
+}
+
+// UnmarshalJSON decodes a JSON string holding a normal mode encoded string, null results in nil
+func (r *RawBytes) UnmarshalJSON(data []byte) error {
+	return (*Bytes)(r).UnmarshalJSON(data)
+}
+
+// Value returns the bytes stored in databases as they are, or nil for nil
+func (r RawBytes) Value() (driver.Value, error) {
+	if r == nil {
+		return nil, nil
+	}
+
+	return []byte(r), nil
+}
+
+// Scan reads bytes stored in databases as they are, while strings are decoded
+func (r *RawBytes) Scan(src interface{}) error {
+	return (*Bytes)(r).scan(src, true)
+}
+
+// Set decodes a normal mode encoded string given as a command line flag
+func (r *RawBytes) Set(str string) error {
+	return (*Bytes)(r).Set(str)
+}
+
+// formatVerb returns a format string for verb keeping the width, the precision and those flags of f which are listed
+func formatVerb(f fmt.State, verb rune, flags string) string {
+	format := []byte{'%'}
+	for i := 0; i < len(flags); i++ {
+		if f.Flag(int(flags[i])) {
+			format = append(format, flags[i])
+		}
+	}
+
+	if width, ok := f.Width(); ok {
+		format = strconv.AppendInt(format, int64(width), 10)
+	}
+
+	if precision, ok := f.Precision(); ok {
+		format = append(format, '.')
+		format = strconv.AppendInt(format, int64(precision), 10)
+	}
+
+	return string(append(format, string(verb)...))
+}
@@ -0,0 +1,303 @@
+package bfh
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Bytes_Format(t *testing.T) {
+	b := Bytes{255, 32, 167, 0, 253}
+
+	tests := []struct {
+		Name           string
+		Format         string
+		Bytes          Bytes
+		ExpectedResult string
+	}{
+		{
+			Name:           "string",
+			Format:         "%s",
+			Bytes:          b,
+			ExpectedResult: "0-zwga-e07x",
+		},
+		{
+			Name:           "value",
+			Format:         "%v",
+			Bytes:          b,
+			ExpectedResult: "0-zwga-e07x",
+		},
+		{
+			Name:           "strict",
+			Format:         "%+s",
+			Bytes:          b,
+			ExpectedResult: "zwga-e07x",
+		},
+		{
+			Name:           "ungrouped",
+			Format:         "%#v",
+			Bytes:          b,
+			ExpectedResult: "0zwgae07x",
+		},
+		{
+			Name:           "strict ungrouped",
+			Format:         "%+#s",
+			Bytes:          b,
+			ExpectedResult: "zwgae07x",
+		},
+		{
+			Name:           "quoted",
+			Format:         "%q",
+			Bytes:          b,
+			ExpectedResult: `"0-zwga-e07x"`,
+		},
+		{
+			Name:           "hexadecimal",
+			Format:         "%x",
+			Bytes:          b,
+			ExpectedResult: "ff20a700fd",
+		},
+		{
+			Name:           "uppercase hexadecimal",
+			Format:         "%#X",
+			Bytes:          b,
+			ExpectedResult: "0XFF20A700FD",
+		},
+		{
+			Name:           "width",
+			Format:         "[%15s]",
+			Bytes:          b,
+			ExpectedResult: "[    0-zwga-e07x]",
+		},
+		{
+			Name:           "left aligned",
+			Format:         "[%-15s]",
+			Bytes:          b,
+			ExpectedResult: "[0-zwga-e07x    ]",
+		},
+		{
+			Name:           "precision",
+			Format:         "%.6v",
+			Bytes:          b,
+			ExpectedResult: "0-zwga",
+		},
+		{
+			Name:           "quoted with width",
+			Format:         "[%-15q]",
+			Bytes:          b,
+			ExpectedResult: `["0-zwga-e07x"  ]`,
+		},
+		{
+			Name:           "hexadecimal with width",
+			Format:         "[%012x]",
+			Bytes:          b,
+			ExpectedResult: "[00ff20a700fd]",
+		},
+		{
+			Name:           "spaced hexadecimal",
+			Format:         "% x",
+			Bytes:          b,
+			ExpectedResult: "ff 20 a7 00 fd",
+		},
+		{
+			Name:           "nil",
+			Format:         "%s",
+			Bytes:          nil,
+			ExpectedResult: "0-",
+		},
+		{
+			Name:           "strict with invalid length",
+			Format:         "%+s",
+			Bytes:          Bytes{1},
+			ExpectedResult: "%!s(bfh.Bytes=" + strictLengthError().Error() + ")",
+		},
+		{
+			Name:           "unsupported verb",
+			Format:         "%d",
+			Bytes:          b,
+			ExpectedResult: "%!d(bfh.Bytes=0-zwga-e07x)",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.ExpectedResult, fmt.Sprintf(tt.Format, tt.Bytes))
+		})
+	}
+}
+
+func Test_Bytes_Text(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		text, err := Bytes{255, 32, 167, 0, 253, 17}.MarshalText()
+		require.NoError(t, err)
+		assert.Equal(t, "4-zwga-e07x-2400-0000", string(text))
+
+		var b Bytes
+		err = b.UnmarshalText(text)
+		require.NoError(t, err)
+		assert.Equal(t, Bytes{255, 32, 167, 0, 253, 17}, b)
+	})
+
+	t.Run("empty text", func(t *testing.T) {
+		b := Bytes{1}
+
+		err := b.UnmarshalText(nil)
+
+		assert.NoError(t, err)
+		assert.Nil(t, b)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		var b Bytes
+
+		err := b.UnmarshalText([]byte("4-zwga-e07u-2400-0000"))
+
+		assert.ErrorIs(t, err, ErrInvalidCharacter)
+	})
+}
+
+func Test_Bytes_JSON(t *testing.T) {
+	type payload struct {
+		Key      Bytes `json:"key"`
+		Optional Bytes `json:"optional"`
+	}
+
+	t.Run("round trip", func(t *testing.T) {
+		data, err := json.Marshal(payload{Key: Bytes{255, 32, 167, 0, 253}})
+		require.NoError(t, err)
+		assert.Equal(t, `{"key":"0-zwga-e07x","optional":null}`, string(data))
+
+		var actual payload
+		err = json.Unmarshal(data, &actual)
+		require.NoError(t, err)
+		assert.Equal(t, payload{Key: Bytes{255, 32, 167, 0, 253}}, actual)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name string
+			JSON string
+		}{
+			{
+				Name: "not a string",
+				JSON: `{"key":12}`,
+			},
+			{
+				Name: "invalid encoding",
+				JSON: `{"key":"0-zwga-e07u"}`,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				var actual payload
+
+				err := json.Unmarshal([]byte(tt.JSON), &actual)
+
+				assert.Error(t, err, fmt.Sprintf("Failing value: %s", tt.JSON))
+			})
+		}
+	})
+}
+
+func Test_Bytes_SQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		value, err := Bytes{255, 32, 167, 0, 253}.Value()
+		require.NoError(t, err)
+		assert.Equal(t, "0-zwga-e07x", value)
+
+		var b Bytes
+		require.NoError(t, b.Scan("0-zwga-e07x"))
+		assert.Equal(t, Bytes{255, 32, 167, 0, 253}, b)
+
+		require.NoError(t, b.Scan([]byte("0-zwga-e07x")))
+		assert.Equal(t, Bytes{255, 32, 167, 0, 253}, b)
+	})
+
+	t.Run("null", func(t *testing.T) {
+		value, err := Bytes(nil).Value()
+		require.NoError(t, err)
+		assert.Nil(t, value)
+
+		b := Bytes{1}
+		require.NoError(t, b.Scan(nil))
+		assert.Nil(t, b)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		var b Bytes
+
+		assert.Error(t, b.Scan(12))
+		assert.ErrorIs(t, b.Scan([]byte{255, 32, 167, 0, 253}), ErrInvalidCharacter)
+	})
+}
+
+func Test_RawBytes_SQL(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		value, err := RawBytes{255, 32, 167, 0, 253}.Value()
+		require.NoError(t, err)
+		assert.Equal(t, []byte{255, 32, 167, 0, 253}, value)
+
+		src := []byte{255, 32, 167, 0, 253}
+
+		var r RawBytes
+		require.NoError(t, r.Scan(src))
+		src[0] = 0
+		assert.Equal(t, RawBytes{255, 32, 167, 0, 253}, r)
+
+		require.NoError(t, r.Scan("0-zwga-e07x"))
+		assert.Equal(t, RawBytes{255, 32, 167, 0, 253}, r)
+	})
+
+	t.Run("null", func(t *testing.T) {
+		value, err := RawBytes(nil).Value()
+		require.NoError(t, err)
+		assert.Nil(t, value)
+
+		r := RawBytes{1}
+		require.NoError(t, r.Scan(nil))
+		assert.Nil(t, r)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		var r RawBytes
+
+		assert.EqualError(t, r.Scan(12), "bfh: can not scan int into RawBytes")
+	})
+}
+
+func Test_RawBytes_Text(t *testing.T) {
+	r := RawBytes{255, 32, 167, 0, 253}
+
+	assert.Equal(t, "0-zwga-e07x", r.String())
+	assert.Equal(t, "zwga-e07x", fmt.Sprintf("%+s", r))
+
+	data, err := json.Marshal(struct{ Key RawBytes }{Key: r})
+	require.NoError(t, err)
+	assert.Equal(t, `{"Key":"0-zwga-e07x"}`, string(data))
+
+	var decoded struct{ Key RawBytes }
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, r, decoded.Key)
+
+	var flagged RawBytes
+	require.NoError(t, flagged.Set("0-zwga-e07x"))
+	assert.Equal(t, r, flagged)
+}
+
+func Test_Bytes_Flag(t *testing.T) {
+	var b Bytes
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&b, "key", "key to use")
+
+	err := fs.Parse([]string{"-key", "0-zwga-e07x"})
+
+	require.NoError(t, err)
+	assert.Equal(t, Bytes{255, 32, 167, 0, 253}, b)
+	assert.Equal(t, "0-zwga-e07x", fs.Lookup("key").Value.String())
+}
@@ -0,0 +1,22 @@
+package bfh
+
+// Canonicalize returns the one well-formatted form of an acceptable string
+func Canonicalize(str string) (string, error) {
+	return StdEncoding.Canonicalize(str)
+}
+
+// Canonicalize returns the one well-formatted form of an acceptable string
+// Separators are moved to their proper places, while strings with non-zero padding bits are rejected with
+// ErrNonCanonical regardless of the encoding being canonical, as they have no well-formatted form.
+func (enc *Encoding) Canonicalize(str string) (string, error) {
+	if err := enc.Validate(str); err != nil {
+		return "", err
+	}
+
+	data, err := enc.DecodeStr(str)
+	if err != nil {
+		return "", err
+	}
+
+	return enc.EncodeStr(data)
+}
@@ -0,0 +1,140 @@
+package bfh
+
+import (
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Canonicalize(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedResult string
+		}{
+			{
+				Name:           "well-formatted",
+				String:         "4-zwga-e07x-2400-0000",
+				ExpectedResult: "4-zwga-e07x-2400-0000",
+			},
+			{
+				Name:           "misplaced dashes",
+				String:         "4zw-gae07x2400-0000",
+				ExpectedResult: "4-zwga-e07x-2400-0000",
+			},
+			{
+				Name:           "no data",
+				String:         "0",
+				ExpectedResult: "0-",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := Canonicalize(tt.String)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+				assert.True(t, IsWellFormatted(actualResult))
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name        string
+			String      string
+			ExpectedErr error
+		}{
+			{
+				Name:        "non-zero padding",
+				String:      "4-zwga-e07x-2400-000z",
+				ExpectedErr: ErrNonCanonical,
+			},
+			{
+				Name:        "invalid character",
+				String:      "4-zwga-e07x-2400-000u",
+				ExpectedErr: ErrInvalidCharacter,
+			},
+			{
+				Name:        "invalid length",
+				String:      "4-zwga",
+				ExpectedErr: ErrInvalidLength,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := Canonicalize(tt.String)
+
+				assert.ErrorIs(t, err, tt.ExpectedErr, fmt.Sprintf("Failing value: %s", tt.String))
+			})
+		}
+	})
+}
+
+func Test_CanonicalDecoding(t *testing.T) {
+	t.Run("standard encoding ignores padding bits", func(t *testing.T) {
+		actualResult, err := DecodeStr("4-zwga-e07x-2400-000z")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{255, 32, 167, 0, 253, 17}, actualResult)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedOffset int
+		}{
+			{
+				Name:           "non-zero trailing bits",
+				String:         "4-zwga-e07x-2401-0000",
+				ExpectedOffset: 15,
+			},
+			{
+				Name:           "non-zero padding byte",
+				String:         "4-zwga-e07x-2400-000z",
+				ExpectedOffset: 20,
+			},
+			{
+				Name:           "non-zero padding with misplaced dashes",
+				String:         "1zzzz-zzr1",
+				ExpectedOffset: 9,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := CanonicalEncoding.DecodeStr(tt.String)
+
+				require.ErrorIs(t, err, ErrNonCanonical, fmt.Sprintf("Failing value: %s", tt.String))
+
+				var corruptErr *CorruptInputError
+				require.ErrorAs(t, err, &corruptErr)
+				assert.Equal(t, tt.ExpectedOffset, corruptErr.Offset)
+			})
+		}
+	})
+
+	t.Run("new encodings are canonical by default", func(t *testing.T) {
+		_, err := MustNewEncoding(digits).DecodeStr("4-zwga-e07x-2400-000z")
+		assert.ErrorIs(t, err, ErrNonCanonical)
+
+		_, err = MustNewEncoding(digits, WithCanonical(false)).DecodeStr("4-zwga-e07x-2400-000z")
+		assert.NoError(t, err)
+	})
+
+	t.Run("canonical stream", func(t *testing.T) {
+		_, err := ioutil.ReadAll(CanonicalEncoding.NewDecoder(strings.NewReader("zwga-e07x-2400-000z-4")))
+		assert.ErrorIs(t, err, ErrNonCanonical)
+
+		_, err = ioutil.ReadAll(NewDecoder(strings.NewReader("zwga-e07x-2400-000z-4")))
+		assert.NoError(t, err)
+	})
+}
@@ -0,0 +1,249 @@
+package bfh
+
+import (
+	"errors"
+)
+
+const (
+	errMsgCheckSymbolsUnavailable = "check symbols are not available for this encoding"
+
+	// checkModulus is the modulus used to calculate check symbols, as defined by Crockford's Base32
+	checkModulus = 37
+	// defaultCheckSymbols are the extra symbols used for the check values 32 to 36
+	defaultCheckSymbols = "*~$=u"
+)
+
+// EncodeCheckedStr encodes binary data into a human readable string followed by a check symbol
+func EncodeCheckedStr(b []byte) (string, error) {
+	return StdEncoding.EncodeCheckedStr(b)
+}
+
+// EncodeStrictCheckedStr encodes binary data into a strict human readable string followed by a check symbol
+func EncodeStrictCheckedStr(b []byte) (string, error) {
+	return StdEncoding.EncodeStrictCheckedStr(b)
+}
+
+// DecodeCheckedStr decodes a human readable string followed by a check symbol
+// ErrChecksumMismatch is returned if the check symbol does not match the decoded data.
+func DecodeCheckedStr(str string) ([]byte, error) {
+	return StdEncoding.DecodeCheckedStr(str)
+}
+
+// DecodeStrictCheckedStr decodes a strict human readable string followed by a check symbol
+// ErrChecksumMismatch is returned if the check symbol does not match the decoded data.
+func DecodeStrictCheckedStr(str string) ([]byte, error) {
+	return StdEncoding.DecodeStrictCheckedStr(str)
+}
+
+// IsWellFormattedChecked returns true if the string is a well-formatted string followed by a matching check symbol
+func IsWellFormattedChecked(str string) bool {
+	return StdEncoding.IsWellFormattedChecked(str)
+}
+
+// IsAcceptableChecked returns true if bfh can accept it for decoding and the check symbol matches
+func IsAcceptableChecked(str string) bool {
+	return StdEncoding.IsAcceptableChecked(str)
+}
+
+// IsStrictChecked returns true if the string is strict-compatible and followed by a matching check symbol
+func IsStrictChecked(str string) bool {
+	return StdEncoding.IsStrictChecked(str)
+}
+
+// EncodeCheckedStr encodes binary data into a human readable string followed by a check symbol
+// The check symbol is the remainder of the data, interpreted as a big-endian unsigned integer, divided by 37. It is
+// separated from the rest of the string the same way groups are.
+func (enc *Encoding) EncodeCheckedStr(b []byte) (string, error) {
+	if enc.checkSymbols == "" {
+		return "", errors.New(errMsgCheckSymbolsUnavailable)
+	}
+
+	result, err := enc.Encode(b)
+	if err != nil {
+		return "", err
+	}
+
+	return string(enc.appendCheckSymbol(result, b)), nil
+}
+
+// EncodeStrictCheckedStr encodes binary data into a strict human readable string followed by a check symbol
+func (enc *Encoding) EncodeStrictCheckedStr(b []byte) (string, error) {
+	if enc.checkSymbols == "" {
+		return "", errors.New(errMsgCheckSymbolsUnavailable)
+	}
+
+	result, err := enc.EncodeStrict(b)
+	if err != nil {
+		return "", err
+	}
+
+	return string(enc.appendCheckSymbol(result, b)), nil
+}
+
+// DecodeCheckedStr decodes a human readable string followed by a check symbol
+// Non-zero padding bits are rejected with ErrNonCanonical regardless of the encoding being canonical, as the check
+// symbol does not cover them.
+func (enc *Encoding) DecodeCheckedStr(str string) ([]byte, error) {
+	return enc.decodeChecked(str, enc.canonicalEncoding().DecodeStr)
+}
+
+// DecodeStrictCheckedStr decodes a strict human readable string followed by a check symbol
+func (enc *Encoding) DecodeStrictCheckedStr(str string) ([]byte, error) {
+	return enc.decodeChecked(str, enc.DecodeStrictStr)
+}
+
+// IsWellFormattedChecked returns true if the string is a well-formatted string followed by a matching check symbol
+func (enc *Encoding) IsWellFormattedChecked(str string) bool {
+	return enc.isChecked(str, enc.IsWellFormatted, enc.canonicalEncoding().DecodeStr)
+}
+
+// IsStrictChecked returns true if the string is strict-compatible and followed by a matching check symbol
+func (enc *Encoding) IsStrictChecked(str string) bool {
+	return enc.isChecked(str, enc.IsStrict, enc.DecodeStrictStr)
+}
+
+// IsAcceptableChecked returns true if bfh can accept it for decoding and the check symbol matches
+func (enc *Encoding) IsAcceptableChecked(str string) bool {
+	if enc.checkSymbols == "" {
+		return false
+	}
+
+	str = RemoveByte(str, enc.separator)
+	if len(str) == 0 {
+		return false
+	}
+
+	check, err := enc.getCheckValue(str[len(str)-1])
+	if err != nil {
+		return false
+	}
+
+	str = str[:len(str)-1]
+	if !enc.IsAcceptable(str) {
+		return false
+	}
+
+	data, err := enc.canonicalEncoding().DecodeStr(str)
+
+	return err == nil && checksum(data) == check
+}
+
+// appendCheckSymbol appends the check symbol of data to an encoded result, preceded by a separator if necessary
+func (enc *Encoding) appendCheckSymbol(result, data []byte) []byte {
+	if enc.groupLength > 0 && len(result) > 0 && result[len(result)-1] != enc.separator {
+		result = append(result, enc.separator)
+	}
+
+	return append(result, enc.getCheckSymbol(checksum(data)))
+}
+
+func (enc *Encoding) decodeChecked(str string, decode func(string) ([]byte, error)) ([]byte, error) {
+	if enc.checkSymbols == "" {
+		return nil, errors.New(errMsgCheckSymbolsUnavailable)
+	}
+
+	// separators are not needed, they only help readability
+	last := len(str) - 1
+	for last >= 0 && str[last] == enc.separator {
+		last--
+	}
+
+	if last < 0 {
+		return nil, newCorruptInputError(str, len(str), ErrInvalidLength)
+	}
+
+	check, err := enc.getCheckValue(str[last])
+	if err != nil {
+		return nil, newCorruptInputError(str, last, err)
+	}
+
+	data, err := decode(str[:last])
+	if err != nil {
+		return nil, err
+	}
+
+	if checksum(data) != check {
+		return nil, ErrChecksumMismatch
+	}
+
+	return data, nil
+}
+
+func (enc *Encoding) isChecked(str string, isValid func(string) bool, decode func(string) ([]byte, error)) bool {
+	if enc.checkSymbols == "" || len(str) == 0 {
+		return false
+	}
+
+	check, err := enc.getCheckValue(str[len(str)-1])
+	if err != nil {
+		return false
+	}
+
+	str = str[:len(str)-1]
+
+	// the check symbol must be separated from the data unless there is no data to separate it from
+	if enc.groupLength > 0 && len(str) > 0 && !isValid(str) {
+		if str[len(str)-1] != enc.separator {
+			return false
+		}
+
+		str = str[:len(str)-1]
+	} else if enc.groupLength > 0 && len(str) > 0 && str[len(str)-1] != enc.separator {
+		return false
+	}
+
+	if !isValid(str) {
+		return false
+	}
+
+	data, err := decode(str)
+
+	return err == nil && checksum(data) == check
+}
+
+// getCheckSymbol returns the symbol representing a check value
+func (enc *Encoding) getCheckSymbol(value byte) byte {
+	if int(value) < len(enc.alphabet) {
+		return enc.alphabet[value]
+	}
+
+	return enc.checkSymbols[int(value)-len(enc.alphabet)]
+}
+
+// getCheckValue returns the check value represented by a check symbol
+func (enc *Encoding) getCheckValue(r uint8) (byte, error) {
+	if value := enc.decodeMap[r]; value != invalidDigit {
+		return value, nil
+	}
+
+	for i := 0; i < len(enc.checkSymbols); i++ {
+		if enc.checkSymbols[i] == r {
+			return byte(len(enc.alphabet) + i), nil
+		}
+	}
+
+	return 0, ErrInvalidCharacter
+}
+
+// canonicalEncoding returns the encoding itself if it is canonical, otherwise a canonical copy of it
+func (enc *Encoding) canonicalEncoding() *Encoding {
+	if enc.canonical {
+		return enc
+	}
+
+	canonical := *enc
+	canonical.canonical = true
+
+	return &canonical
+}
+
+// checksum returns the remainder of the data, interpreted as a big-endian unsigned integer, divided by 37
+func checksum(b []byte) byte {
+	var r uint
+
+	for _, c := range b {
+		r = (r<<8 | uint(c)) % checkModulus
+	}
+
+	return byte(r)
+}
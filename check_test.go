@@ -0,0 +1,472 @@
+package bfh
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EncodeCheckedStr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Bytes          []byte
+			ExpectedResult string
+		}{
+			{
+				Name:           "empty",
+				Bytes:          []byte{},
+				ExpectedResult: "0-0",
+			},
+			{
+				Name:           "0xff",
+				Bytes:          []byte{255},
+				ExpectedResult: "4-zw00-0000-~",
+			},
+			{
+				Name:           "zeros",
+				Bytes:          []byte{0, 0, 0, 0, 0},
+				ExpectedResult: "0-0000-0000-0",
+			},
+			{
+				Name:           "somewhat random numbers",
+				Bytes:          []byte{255, 32, 167, 0, 253, 17},
+				ExpectedResult: "4-zwga-e07x-2400-0000-f",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := EncodeCheckedStr(tt.Bytes)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("no grouping", func(t *testing.T) {
+		enc := MustNewEncoding(digits, WithGroupLength(0))
+
+		actualResult, err := enc.EncodeCheckedStr([]byte{255})
+
+		assert.NoError(t, err)
+		assert.Equal(t, "4zw000000~", actualResult)
+	})
+
+	t.Run("fail on nil", func(t *testing.T) {
+		_, err := EncodeCheckedStr(nil)
+
+		assert.Error(t, err)
+	})
+
+	t.Run("fail without check symbols", func(t *testing.T) {
+		enc := MustNewEncoding("0123456789abcdefghijklmnopqrstuv")
+
+		_, err := enc.EncodeCheckedStr([]byte{255})
+
+		assert.Error(t, err)
+	})
+}
+
+func Test_EncodeStrictCheckedStr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Bytes          []byte
+			ExpectedResult string
+		}{
+			{
+				Name:           "empty",
+				Bytes:          []byte{},
+				ExpectedResult: "0",
+			},
+			{
+				Name:           "somewhat random numbers",
+				Bytes:          []byte{255, 32, 167, 0, 253},
+				ExpectedResult: "zwga-e07x-d",
+			},
+			{
+				Name:           "small numbers",
+				Bytes:          []byte{1, 2, 3, 4, 5},
+				ExpectedResult: "0410-6105-a",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := EncodeStrictCheckedStr(tt.Bytes)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("fail on wrong length", func(t *testing.T) {
+		_, err := EncodeStrictCheckedStr([]byte{1, 2, 3})
+
+		assert.Error(t, err)
+	})
+}
+
+func Test_DecodeCheckedStr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedResult []byte
+		}{
+			{
+				Name:           "empty",
+				String:         "0-0",
+				ExpectedResult: []byte{},
+			},
+			{
+				Name:           "0xff",
+				String:         "4-zw00-0000-~",
+				ExpectedResult: []byte{255},
+			},
+			{
+				Name:           "without dashes",
+				String:         "4zw000000~",
+				ExpectedResult: []byte{255},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := DecodeCheckedStr(tt.String)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			String string
+		}{
+			{
+				Name:   "wrong check symbol",
+				String: "4-zw00-0000-*",
+			},
+			{
+				Name:   "typo in data",
+				String: "4-yw00-0000-~",
+			},
+			{
+				Name:   "transposed characters",
+				String: "4-zwga-e0x7-2400-0000-f",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := DecodeCheckedStr(tt.String)
+
+				assert.Equal(t, ErrChecksumMismatch, err, fmt.Sprintf("Failing value: %s", tt.String))
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			String string
+		}{
+			{
+				Name:   "empty",
+				String: "",
+			},
+			{
+				Name:   "invalid check symbol",
+				String: "4-zw00-0000-#",
+			},
+			{
+				Name:   "missing check symbol",
+				String: "4-zw00-0000",
+			},
+			{
+				Name:   "check symbol only",
+				String: "~",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := DecodeCheckedStr(tt.String)
+
+				assert.Error(t, err, fmt.Sprintf("Failing value: %s", tt.String))
+			})
+		}
+	})
+
+	t.Run("failure on mistyped padding characters", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			String string
+		}{
+			{
+				Name:   "padding bits",
+				String: "4-04zz-zzzz-1",
+			},
+			{
+				Name:   "last padding digit",
+				String: "4-0400-0001-1",
+			},
+			{
+				Name:   "non-zero padding byte",
+				String: "1-zwga-e07x-2400-00z0-f",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := DecodeCheckedStr(tt.String)
+
+				assert.ErrorIs(t, err, ErrNonCanonical, fmt.Sprintf("Failing value: %s", tt.String))
+				assert.False(t, IsAcceptableChecked(tt.String), fmt.Sprintf("Failing value: %s", tt.String))
+				assert.False(t, IsWellFormattedChecked(tt.String), fmt.Sprintf("Failing value: %s", tt.String))
+			})
+		}
+	})
+
+	t.Run("random success", func(t *testing.T) {
+		for _, length := range []int{1, 37, 69, 120, 141} {
+			t.Run(fmt.Sprintf("%d", length), func(t *testing.T) {
+				b := make([]byte, length)
+
+				_, err := rand.Read(b)
+				require.NoError(t, err)
+
+				encoded, err := EncodeCheckedStr(b)
+				require.NoError(t, err)
+
+				assert.True(t, IsWellFormattedChecked(encoded), fmt.Sprintf("Failing value: %s", encoded))
+				assert.True(t, IsAcceptableChecked(encoded), fmt.Sprintf("Failing value: %s", encoded))
+
+				decoded, err := DecodeCheckedStr(encoded)
+				require.NoError(t, err)
+
+				assert.Equal(t, b, decoded)
+			})
+		}
+	})
+}
+
+func Test_DecodeStrictCheckedStr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		actualResult, err := DecodeStrictCheckedStr("zwga-e07x-d")
+
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{255, 32, 167, 0, 253}, actualResult)
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		_, err := DecodeStrictCheckedStr("zwga-e07x-e")
+
+		assert.Equal(t, ErrChecksumMismatch, err)
+	})
+
+	t.Run("random success", func(t *testing.T) {
+		for _, length := range []int{0, 5, 40, 80} {
+			t.Run(fmt.Sprintf("%d", length), func(t *testing.T) {
+				b := make([]byte, length)
+
+				_, err := rand.Read(b)
+				require.NoError(t, err)
+
+				encoded, err := EncodeStrictCheckedStr(b)
+				require.NoError(t, err)
+
+				assert.True(t, IsStrictChecked(encoded), fmt.Sprintf("Failing value: %s", encoded))
+
+				decoded, err := DecodeStrictCheckedStr(encoded)
+				require.NoError(t, err)
+
+				assert.Equal(t, b, decoded)
+			})
+		}
+	})
+}
+
+func Test_IsWellFormattedChecked(t *testing.T) {
+	tests := []struct {
+		Name           string
+		String         string
+		ExpectedResult bool
+	}{
+		{
+			Name:           "empty data",
+			String:         "0-0",
+			ExpectedResult: true,
+		},
+		{
+			Name:           "valid",
+			String:         "4-zwga-e07x-2400-0000-f",
+			ExpectedResult: true,
+		},
+		{
+			Name:           "empty",
+			String:         "",
+			ExpectedResult: false,
+		},
+		{
+			Name:           "missing separator",
+			String:         "4-zwga-e07x-2400-0000f",
+			ExpectedResult: false,
+		},
+		{
+			Name:           "double separator",
+			String:         "4-zwga-e07x-2400-0000--f",
+			ExpectedResult: false,
+		},
+		{
+			Name:           "wrong check symbol",
+			String:         "4-zwga-e07x-2400-0000-g",
+			ExpectedResult: false,
+		},
+		{
+			Name:           "not well formatted",
+			String:         "4-zwgae07x-2400-0000-f",
+			ExpectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			actualResult := IsWellFormattedChecked(tt.String)
+
+			assert.Equal(t, tt.ExpectedResult, actualResult, fmt.Sprintf("Failing value: %s", tt.String))
+		})
+	}
+}
+
+func Test_IsAcceptableChecked(t *testing.T) {
+	tests := []struct {
+		Name           string
+		String         string
+		ExpectedResult bool
+	}{
+		{
+			Name:           "valid",
+			String:         "4-zwga-e07x-2400-0000-f",
+			ExpectedResult: true,
+		},
+		{
+			Name:           "missing separator",
+			String:         "4-zwga-e07x-2400-0000f",
+			ExpectedResult: true,
+		},
+		{
+			Name:           "random dashes",
+			String:         "4z-wgae07x-2400-00-00f",
+			ExpectedResult: true,
+		},
+		{
+			Name:           "wrong check symbol",
+			String:         "4-zwga-e07x-2400-0000-g",
+			ExpectedResult: false,
+		},
+		{
+			Name:           "missing check symbol",
+			String:         "4-zwga-e07x-2400-0000",
+			ExpectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			actualResult := IsAcceptableChecked(tt.String)
+
+			assert.Equal(t, tt.ExpectedResult, actualResult, fmt.Sprintf("Failing value: %s", tt.String))
+		})
+	}
+}
+
+func Test_IsStrictChecked(t *testing.T) {
+	tests := []struct {
+		Name           string
+		String         string
+		ExpectedResult bool
+	}{
+		{
+			Name:           "empty data",
+			String:         "0",
+			ExpectedResult: true,
+		},
+		{
+			Name:           "valid",
+			String:         "zwga-e07x-d",
+			ExpectedResult: true,
+		},
+		{
+			Name:           "missing separator",
+			String:         "zwga-e07xd",
+			ExpectedResult: false,
+		},
+		{
+			Name:           "wrong check symbol",
+			String:         "zwga-e07x-e",
+			ExpectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			actualResult := IsStrictChecked(tt.String)
+
+			assert.Equal(t, tt.ExpectedResult, actualResult, fmt.Sprintf("Failing value: %s", tt.String))
+		})
+	}
+}
+
+func Test_NewEncoding_CheckSymbols(t *testing.T) {
+	t.Run("custom check symbols", func(t *testing.T) {
+		enc, err := NewEncoding("0123456789abcdefghijklmnopqrstuv", WithCheckSymbols("wxyz#"))
+		require.NoError(t, err)
+
+		encoded, err := enc.EncodeCheckedStr([]byte{255})
+		require.NoError(t, err)
+
+		assert.Equal(t, "4-vs00-0000-x", encoded)
+	})
+
+	t.Run("fail on invalid check symbols", func(t *testing.T) {
+		tests := []struct {
+			Name    string
+			Symbols string
+		}{
+			{
+				Name:    "too short",
+				Symbols: "*~$=",
+			},
+			{
+				Name:    "duplicate",
+				Symbols: "*~$==",
+			},
+			{
+				Name:    "part of alphabet",
+				Symbols: "*~$=0",
+			},
+			{
+				Name:    "separator",
+				Symbols: "*~$=-",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := NewEncoding(digits, WithCheckSymbols(tt.Symbols))
+
+				assert.Error(t, err)
+			})
+		}
+	})
+}
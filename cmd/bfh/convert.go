@@ -0,0 +1,78 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	bfh "github.com/peteraba/binary4humans"
+)
+
+// sniffScheme is the value of the from option guessing the scheme of the input
+const sniffScheme = "auto"
+
+func runConvert(args []string, s streams) error {
+	fs := newFlagSet("convert", "[file]", s.stderr)
+	from := fs.String("from", sniffScheme, "the scheme of the input: "+listSchemes()+" or auto to guess it")
+	to := fs.String("to", string(bfh.SchemeBFH), "the scheme of the output: "+listSchemes())
+
+	if err := fs.Parse(args); err != nil {
+		// the flag package has already reported the problem
+		return errUsage
+	}
+
+	if *from != sniffScheme && !isScheme(*from) {
+		return usageError(fs, fmt.Sprintf("unknown scheme %q", *from))
+	}
+
+	if !isScheme(*to) {
+		return usageError(fs, fmt.Sprintf("unknown scheme %q", *to))
+	}
+
+	input, err := readInput(fs, s.stdin)
+	if err != nil {
+		return err
+	}
+
+	// whitespace is dropped, so that wrapped lines and grouped secrets are accepted
+	text := strings.Join(strings.Fields(string(input)), "")
+
+	source := bfh.Scheme(*from)
+	if *from == sniffScheme {
+		candidates := bfh.Sniff(text)
+		if len(candidates) == 0 {
+			return errors.New("the scheme of the input can not be guessed")
+		}
+
+		source = candidates[0]
+		fmt.Fprintf(s.stderr, "bfh convert: reading %s\n", source)
+	}
+
+	result, err := bfh.Transcode(text, source, bfh.Scheme(*to))
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintln(s.stdout, result)
+
+	return err
+}
+
+func isScheme(name string) bool {
+	for _, scheme := range bfh.Schemes {
+		if string(scheme) == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func listSchemes() string {
+	names := make([]string, len(bfh.Schemes))
+	for i, scheme := range bfh.Schemes {
+		names[i] = string(scheme)
+	}
+
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Convert(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Args           []string
+			Stdin          string
+			ExpectedStdout string
+			ExpectedStderr string
+		}{
+			{
+				Name:           "hex to bfh",
+				Args:           []string{"convert", "--from=hex"},
+				Stdin:          "ff20a700fd11\n",
+				ExpectedStdout: "4-zwga-e07x-2400-0000\n",
+			},
+			{
+				Name:           "bfh to hex",
+				Args:           []string{"convert", "--from=bfh", "--to=hex"},
+				Stdin:          "4-zwga-e07x-2400-0000",
+				ExpectedStdout: "ff20a700fd11\n",
+			},
+			{
+				Name:           "grouped base32 to bfh strict",
+				Args:           []string{"convert", "--from", "base32", "--to", "bfh-strict"},
+				Stdin:          "74qk oah5",
+				ExpectedStdout: "zwga-e07x\n",
+			},
+			{
+				Name:           "guessed",
+				Args:           []string{"convert", "--to=base64url"},
+				Stdin:          "zwga-e07x",
+				ExpectedStdout: "_yCnAP0\n",
+				ExpectedStderr: "bfh convert: reading bfh-strict\n",
+			},
+			{
+				Name:           "guessed base64 with padding",
+				Args:           []string{"convert", "--to=hex"},
+				Stdin:          "aGVsbG8=\n",
+				ExpectedStdout: "68656c6c6f\n",
+				ExpectedStderr: "bfh convert: reading base64\n",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				code, stdout, stderr := execute(tt.Stdin, tt.Args...)
+
+				assert.Equal(t, exitOK, code, stderr)
+				assert.Equal(t, tt.ExpectedStdout, stdout)
+				assert.Equal(t, tt.ExpectedStderr, stderr)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name         string
+			Args         []string
+			Stdin        string
+			ExpectedCode int
+		}{
+			{
+				Name:         "unknown source",
+				Args:         []string{"convert", "--from=base58"},
+				Stdin:        "ff",
+				ExpectedCode: exitUsage,
+			},
+			{
+				Name:         "unknown target",
+				Args:         []string{"convert", "--to=base58"},
+				Stdin:        "ff",
+				ExpectedCode: exitUsage,
+			},
+			{
+				Name:         "invalid input",
+				Args:         []string{"convert", "--from=hex"},
+				Stdin:        "fff",
+				ExpectedCode: exitError,
+			},
+			{
+				Name:         "unknown input",
+				Args:         []string{"convert"},
+				Stdin:        "!",
+				ExpectedCode: exitError,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				code, stdout, stderr := execute(tt.Stdin, tt.Args...)
+
+				assert.Equal(t, tt.ExpectedCode, code)
+				assert.Empty(t, stdout)
+				assert.NotEmpty(t, stderr)
+			})
+		}
+	})
+}
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	bfh "github.com/peteraba/binary4humans"
+)
+
+func runDecode(args []string, s streams) error {
+	fs := newFlagSet("decode", "[file]", s.stderr)
+	strict := fs.Bool("strict", false, "decode in strict mode")
+	auto := fs.Bool("auto", false, "detect the format, including the self-describing header")
+	asHex := fs.Bool("hex", false, "write the data as hexadecimal text instead of raw bytes")
+
+	if err := fs.Parse(args); err != nil {
+		// the flag package has already reported the problem
+		return errUsage
+	}
+
+	if *strict && *auto {
+		return usageError(fs, "strict and auto are mutually exclusive")
+	}
+
+	input, err := readInput(fs, s.stdin)
+	if err != nil {
+		return err
+	}
+
+	// whitespace is dropped, so that wrapped lines and trailing newlines are accepted
+	text := strings.Join(strings.Fields(string(input)), "")
+
+	var data []byte
+	switch {
+	case *strict:
+		data, err = bfh.DecodeStrictStr(text)
+	case *auto:
+		data, _, err = bfh.DecodeAuto(text)
+	default:
+		data, err = bfh.DecodeStr(text)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if *asHex {
+		_, err = fmt.Fprintln(s.stdout, hex.EncodeToString(data))
+		return err
+	}
+
+	_, err = s.stdout.Write(data)
+
+	return err
+}
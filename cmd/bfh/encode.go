@@ -0,0 +1,65 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	bfh "github.com/peteraba/binary4humans"
+)
+
+func runEncode(args []string, s streams) error {
+	fs := newFlagSet("encode", "[file]", s.stderr)
+	strict := fs.Bool("strict", false, "encode in strict mode, the length of the data must be some multiple of 5")
+	wrap := fs.Int("wrap", 0, "wrap lines after this many characters, 0 disables wrapping")
+	noDashes := fs.Bool("no-dashes", false, "strip the dashes separating groups")
+
+	if err := fs.Parse(args); err != nil {
+		// the flag package has already reported the problem
+		return errUsage
+	}
+
+	if *wrap < 0 {
+		return usageError(fs, "wrap must not be negative")
+	}
+
+	data, err := readInput(fs, s.stdin)
+	if err != nil {
+		return err
+	}
+
+	encode := bfh.Encode
+	if *strict {
+		encode = bfh.EncodeStrict
+	}
+
+	result, err := encode(data)
+	if err != nil {
+		return err
+	}
+
+	if *noDashes {
+		result = bytes.ReplaceAll(result, []byte{'-'}, nil)
+	}
+
+	return writeWrapped(s.stdout, result, *wrap)
+}
+
+// writeWrapped writes text to w, breaking lines after every width characters, and terminates it with a newline
+func writeWrapped(w io.Writer, text []byte, width int) error {
+	if width == 0 {
+		width = len(text)
+	}
+
+	for len(text) > width {
+		if _, err := fmt.Fprintf(w, "%s\n", text[:width]); err != nil {
+			return err
+		}
+
+		text = text[width:]
+	}
+
+	_, err := fmt.Fprintf(w, "%s\n", text)
+
+	return err
+}
@@ -0,0 +1,199 @@
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+
+	bfh "github.com/peteraba/binary4humans"
+)
+
+// randReader is the source of randomness of generate, replaced by tests
+var randReader io.Reader = rand.Reader
+
+// digests maps the values of the digest option to the functions calculating the second column
+var digests = map[string]func([]byte) string{
+	"none": nil,
+	"hex":  hex.EncodeToString,
+	"sha256": func(data []byte) string {
+		sum := sha256.Sum256(data)
+		return hex.EncodeToString(sum[:])
+	},
+}
+
+func runGenerate(args []string, s streams) error {
+	fs := newFlagSet("generate", "", s.stderr)
+	count := fs.Int("count", 1, "the number of tokens to generate")
+	nBytes := fs.Int("bytes", 10, "the number of random bytes per token, rounded up to some multiple of 5 in strict mode")
+	strict := fs.Bool("strict", false, "encode the tokens in strict mode")
+	check := fs.Bool("check", false, "append a check symbol to the tokens")
+	format := fs.String("format", "text", "the output format: text, csv or json")
+	digestName := fs.String("digest", "none", "write a second column of the raw values: none, hex or sha256")
+
+	if err := fs.Parse(args); err != nil {
+		// the flag package has already reported the problem
+		return errUsage
+	}
+
+	if fs.NArg() > 0 {
+		return usageError(fs, "too many arguments")
+	}
+
+	if *count < 1 || *nBytes < 1 {
+		return usageError(fs, "count and bytes must be positive")
+	}
+
+	if *strict {
+		*nBytes = (*nBytes + 4) / 5 * 5
+	}
+
+	// tokens are unique within the batch, which needs enough possible values
+	if *nBytes < 8 && float64(*count) > math.Pow(256, float64(*nBytes)) {
+		return usageError(fs, fmt.Sprintf("%d bytes can not make %d unique tokens", *nBytes, *count))
+	}
+
+	digest, ok := digests[*digestName]
+	if !ok {
+		return usageError(fs, fmt.Sprintf("unknown digest %q", *digestName))
+	}
+
+	w := bufio.NewWriter(s.stdout)
+
+	var write func(token, digest string) error
+	switch *format {
+	case "text":
+		write = textWriter(w)
+	case "csv":
+		write = csvWriter(w, *digestName)
+	case "json":
+		write = jsonWriter(w, *digestName)
+	default:
+		return usageError(fs, fmt.Sprintf("unknown format %q", *format))
+	}
+
+	encode := tokenEncoder(*strict, *check)
+	seen := make(map[string]struct{}, *count)
+
+	for len(seen) < *count {
+		data := make([]byte, *nBytes)
+		if _, err := io.ReadFull(randReader, data); err != nil {
+			return err
+		}
+
+		if _, ok := seen[string(data)]; ok {
+			continue
+		}
+
+		seen[string(data)] = struct{}{}
+
+		token, err := encode(data)
+		if err != nil {
+			return err
+		}
+
+		var column string
+		if digest != nil {
+			column = digest(data)
+		}
+
+		if err := write(token, column); err != nil {
+			return err
+		}
+	}
+
+	return w.Flush()
+}
+
+// tokenEncoder returns the encoding function matching the options
+func tokenEncoder(strict, check bool) func([]byte) (string, error) {
+	switch {
+	case strict && check:
+		return bfh.EncodeStrictCheckedStr
+	case strict:
+		return bfh.EncodeStrictStr
+	case check:
+		return bfh.EncodeCheckedStr
+	default:
+		return bfh.EncodeStr
+	}
+}
+
+// textWriter writes a token per line, followed by the digest if there is one
+func textWriter(w io.Writer) func(token, digest string) error {
+	return func(token, digest string) error {
+		if digest == "" {
+			_, err := fmt.Fprintln(w, token)
+			return err
+		}
+
+		_, err := fmt.Fprintf(w, "%s %s\n", token, digest)
+
+		return err
+	}
+}
+
+// csvWriter writes a header row, then a row per token, with a second column for the digest if there is one
+func csvWriter(w io.Writer, digestName string) func(token, digest string) error {
+	cw := csv.NewWriter(w)
+	header := true
+
+	return func(token, digest string) error {
+		var row []string
+		if header {
+			row = []string{"token"}
+			if digestName != "none" {
+				row = append(row, digestName)
+			}
+
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+
+			header = false
+		}
+
+		row = []string{token}
+		if digestName != "none" {
+			row = append(row, digest)
+		}
+
+		if err := cw.Write(row); err != nil {
+			return err
+		}
+
+		cw.Flush()
+
+		return cw.Error()
+	}
+}
+
+// generatedToken is a token written as JSON, along with the digest requested
+type generatedToken struct {
+	Token  string `json:"token"`
+	Hex    string `json:"hex,omitempty"`
+	SHA256 string `json:"sha256,omitempty"`
+}
+
+// jsonWriter writes a JSON object per token, with the digest named after its kind if there is one
+func jsonWriter(w io.Writer, digestName string) func(token, digest string) error {
+	enc := json.NewEncoder(w)
+
+	return func(token, digest string) error {
+		object := generatedToken{Token: token}
+
+		switch digestName {
+		case "hex":
+			object.Hex = digest
+		case "sha256":
+			object.SHA256 = digest
+		}
+
+		return enc.Encode(object)
+	}
+}
@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withRandom replaces the source of randomness, returning a function restoring the original one
+func withRandom(random []byte) func() {
+	original := randReader
+	randReader = bytes.NewReader(random)
+
+	return func() { randReader = original }
+}
+
+func Test_Generate(t *testing.T) {
+	random := []byte{
+		255, 32, 167, 0, 253,
+		255, 32, 167, 0, 253,
+		1, 2, 3, 4, 5,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Args           []string
+			ExpectedStdout string
+		}{
+			{
+				Name:           "text",
+				Args:           []string{"generate", "--count", "2", "--bytes", "5"},
+				ExpectedStdout: "0-zwga-e07x\n0-0410-6105\n",
+			},
+			{
+				Name:           "strict with check symbols",
+				Args:           []string{"generate", "--count=2", "--bytes=5", "--strict", "--check"},
+				ExpectedStdout: "zwga-e07x-d\n0410-6105-a\n",
+			},
+			{
+				Name:           "strict rounded up",
+				Args:           []string{"generate", "--bytes=4", "--strict"},
+				ExpectedStdout: "zwga-e07x\n",
+			},
+			{
+				Name:           "text with hex",
+				Args:           []string{"generate", "--bytes=5", "--digest=hex"},
+				ExpectedStdout: "0-zwga-e07x ff20a700fd\n",
+			},
+			{
+				Name: "csv with sha256",
+				Args: []string{"generate", "--count=2", "--bytes=5", "--format=csv", "--digest=sha256"},
+				ExpectedStdout: "token,sha256\n" +
+					"0-zwga-e07x,95339e71c63785920ca6295295b83c07b0fed697040e7bcd6864897f87a4a939\n" +
+					"0-0410-6105,74f81fe167d99b4cb41d6d0ccda82278caee9f3e2f25d5e5a3936ff3dcec60d0\n",
+			},
+			{
+				Name:           "csv",
+				Args:           []string{"generate", "--bytes=5", "--format=csv"},
+				ExpectedStdout: "token\n0-zwga-e07x\n",
+			},
+			{
+				Name: "json with hex",
+				Args: []string{"generate", "--count=2", "--bytes=5", "--format=json", "--digest=hex"},
+				ExpectedStdout: `{"token":"0-zwga-e07x","hex":"ff20a700fd"}` + "\n" +
+					`{"token":"0-0410-6105","hex":"0102030405"}` + "\n",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				defer withRandom(random)()
+
+				code, stdout, stderr := execute("", tt.Args...)
+
+				assert.Equal(t, exitOK, code, stderr)
+				assert.Equal(t, tt.ExpectedStdout, stdout)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name         string
+			Args         []string
+			ExpectedCode int
+		}{
+			{
+				Name:         "not enough randomness",
+				Args:         []string{"generate", "--count=3", "--bytes=5"},
+				ExpectedCode: exitError,
+			},
+			{
+				Name:         "zero count",
+				Args:         []string{"generate", "--count=0"},
+				ExpectedCode: exitUsage,
+			},
+			{
+				Name:         "too many tokens",
+				Args:         []string{"generate", "--count=257", "--bytes=1"},
+				ExpectedCode: exitUsage,
+			},
+			{
+				Name:         "unknown format",
+				Args:         []string{"generate", "--format=xml"},
+				ExpectedCode: exitUsage,
+			},
+			{
+				Name:         "unknown digest",
+				Args:         []string{"generate", "--digest=md5"},
+				ExpectedCode: exitUsage,
+			},
+			{
+				Name:         "argument",
+				Args:         []string{"generate", "file"},
+				ExpectedCode: exitUsage,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				defer withRandom(random)()
+
+				code, _, stderr := execute("", tt.Args...)
+
+				assert.Equal(t, tt.ExpectedCode, code)
+				assert.NotEmpty(t, stderr)
+			})
+		}
+	})
+
+	t.Run("unique", func(t *testing.T) {
+		code, stdout, stderr := execute("", "generate", "--count=256", "--bytes=1")
+		require.Equal(t, exitOK, code, stderr)
+
+		lines := strings.Split(strings.TrimSpace(stdout), "\n")
+		assert.Len(t, lines, 256)
+
+		seen := make(map[string]bool, len(lines))
+		for _, line := range lines {
+			assert.False(t, seen[line], line)
+			seen[line] = true
+		}
+	})
+}
@@ -0,0 +1,238 @@
+package main
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	bfh "github.com/peteraba/binary4humans"
+)
+
+const (
+	ansiReset = "\x1b[0m"
+	ansiBold  = "\x1b[1m"
+	ansiRed   = "\x1b[31m"
+)
+
+// groupColors are cycled through to tell the groups of characters apart
+var groupColors = []string{"\x1b[36m", "\x1b[33m", "\x1b[35m", "\x1b[32m"}
+
+func runInspect(args []string, s streams) error {
+	fs := newFlagSet("inspect", "[file]", s.stderr)
+	color := fs.Bool("color", false, "colour the groups of characters using ANSI escape codes")
+
+	if err := fs.Parse(args); err != nil {
+		// the flag package has already reported the problem
+		return errUsage
+	}
+
+	input, err := readInput(fs, s.stdin)
+	if err != nil {
+		return err
+	}
+
+	report, err := bfh.Explain(strings.TrimSpace(string(input)))
+
+	p := &printer{w: s.stdout, color: *color}
+	p.report(report)
+
+	if p.err != nil {
+		return p.err
+	}
+
+	// the reason is already part of the report
+	if err != nil {
+		return errors.New("the token can not be decoded")
+	}
+
+	return nil
+}
+
+// printer writes a report as aligned rows of text, remembering the first error
+type printer struct {
+	w     io.Writer
+	color bool
+	err   error
+}
+
+func (p *printer) printf(format string, args ...interface{}) {
+	if p.err == nil {
+		_, p.err = fmt.Fprintf(p.w, format, args...)
+	}
+}
+
+// paint wraps text in an ANSI escape code if colouring is enabled
+func (p *printer) paint(text, code string) string {
+	if !p.color || code == "" {
+		return text
+	}
+
+	return code + text + ansiReset
+}
+
+// row writes a label followed by cells padded to width, painted by the codes given
+func (p *printer) row(label string, cells []string, width int, codes []string) {
+	p.printf("  %-8s", label)
+
+	for i, cell := range cells {
+		padded := fmt.Sprintf("%-*s", width, cell)
+		if i == len(cells)-1 {
+			padded = cell
+		}
+
+		p.printf("%s", p.paint(padded, codes[i]))
+	}
+
+	p.printf("\n")
+}
+
+func (p *printer) report(r *bfh.Report) {
+	p.printf("%-9s%s\n", "input", r.Input)
+
+	if r.Strict {
+		p.printf("%-9s%s\n", "mode", "strict")
+	} else {
+		p.printf("%-9s%s\n", "mode", "normal")
+		p.printf("%-9s%s at offset %d: %s\n", "header", p.paint(strconv.QuoteRune(r.Header.Rune), ansiBold),
+			r.Header.Offset, describePadding(r.Header))
+	}
+
+	for i, packet := range r.Packets {
+		p.printf("\npacket %d\n", i+1)
+		p.packet(packet)
+	}
+
+	p.printf("\n")
+
+	if r.Err != nil {
+		p.printf("%-9s%s\n", "error", p.paint(r.Err.Error(), ansiRed))
+		return
+	}
+
+	p.printf("%-9s%d bytes\n", "data", len(r.Data))
+	p.printf("%-9s%s\n", "hex", hex.EncodeToString(r.Data))
+	p.printf("%-9s%s\n", "base64", base64.StdEncoding.EncodeToString(r.Data))
+	p.printf("%-9s%s\n", "ascii", printable(r.Data))
+
+	for _, packet := range r.Packets {
+		for _, c := range packet.Chars {
+			if !c.NonCanonical() {
+				continue
+			}
+
+			warning := fmt.Sprintf("non-zero trailing bits %q at offset %d", c.Rune, c.Offset)
+			p.printf("%-9s%s\n", "warning", p.paint(warning, ansiRed))
+		}
+	}
+}
+
+func (p *printer) packet(packet bfh.PacketReport) {
+	var (
+		chars, offsets, values, bits, padding []string
+		charCodes                             []string
+		hasPadding                            bool
+	)
+
+	for _, c := range packet.Chars {
+		code := groupColors[c.Group%len(groupColors)]
+		value, bitString := strconv.Itoa(int(c.Value)), c.Bits()
+
+		if !c.Valid {
+			code, value, bitString = ansiRed, "?", "?????"
+		}
+
+		chars = append(chars, string(c.Rune))
+		offsets = append(offsets, strconv.Itoa(c.Offset))
+		values = append(values, value)
+		bits = append(bits, bitString)
+		padding = append(padding, describePaddingBits(c))
+		charCodes = append(charCodes, code)
+
+		hasPadding = hasPadding || c.PaddingMask != 0
+	}
+
+	p.row("chars", chars, 6, charCodes)
+	p.row("offset", offsets, 6, charCodes)
+	p.row("values", values, 6, charCodes)
+	p.row("bits", bits, 6, charCodes)
+
+	if hasPadding {
+		paddingCodes := make([]string, len(packet.Chars))
+		for i, c := range packet.Chars {
+			if c.NonCanonical() {
+				paddingCodes[i] = ansiRed
+			}
+		}
+
+		p.row("padding", padding, 6, paddingCodes)
+	}
+
+	if len(packet.Bytes) == 0 {
+		return
+	}
+
+	var byteBits, byteHex []string
+	for _, b := range packet.Bytes {
+		hexString := fmt.Sprintf("%02x", b.Value)
+		if b.Padding {
+			hexString += "*"
+		}
+
+		byteBits = append(byteBits, fmt.Sprintf("%08b", b.Value))
+		byteHex = append(byteHex, hexString)
+	}
+
+	byteCodes := make([]string, len(packet.Bytes))
+	p.row("bytes", byteBits, 9, byteCodes)
+	p.row("hex", byteHex, 9, byteCodes)
+}
+
+// describePadding describes the padding given by the header
+func describePadding(header *bfh.CharReport) string {
+	switch {
+	case !header.Valid:
+		return "not a digit"
+	case header.Value > 4:
+		return "invalid padding, it must be 0 to 4"
+	case header.Value == 1:
+		return "1 padding byte"
+	default:
+		return fmt.Sprintf("%d padding bytes", header.Value)
+	}
+}
+
+// describePaddingBits marks the padding bits of a character with _ if they are zeros and ! otherwise
+func describePaddingBits(c bfh.CharReport) string {
+	var sb strings.Builder
+
+	for bit := byte(0x10); bit > 0; bit >>= 1 {
+		switch {
+		case c.PaddingMask&bit == 0:
+			sb.WriteByte(' ')
+		case c.Value&bit == 0:
+			sb.WriteByte('_')
+		default:
+			sb.WriteByte('!')
+		}
+	}
+
+	return strings.TrimRight(sb.String(), " ")
+}
+
+// printable returns data with the bytes not being printable ASCII characters replaced by dots
+func printable(data []byte) string {
+	result := make([]byte, len(data))
+
+	for i, b := range data {
+		result[i] = '.'
+		if b >= 0x20 && b < 0x7f {
+			result[i] = b
+		}
+	}
+
+	return string(result)
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Inspect(t *testing.T) {
+	t.Run("normal", func(t *testing.T) {
+		code, stdout, stderr := execute("4-zwga-e07x-2400-0000\n", "inspect")
+
+		assert.Equal(t, exitOK, code, stderr)
+		assert.Equal(t, `input    4-zwga-e07x-2400-0000
+mode     normal
+header   '4' at offset 0: 4 padding bytes
+
+packet 1
+  chars   z     w     g     a     e     0     7     x
+  offset  2     3     4     5     7     8     9     10
+  values  31    28    16    10    14    0     7     29
+  bits    11111 11100 10000 01010 01110 00000 00111 11101
+  bytes   11111111 00100000 10100111 00000000 11111101
+  hex     ff       20       a7       00       fd
+
+packet 2
+  chars   2     4     0     0     0     0     0     0
+  offset  12    13    14    15    17    18    19    20
+  values  2     4     0     0     0     0     0     0
+  bits    00010 00100 00000 00000 00000 00000 00000 00000
+  padding          __ _____ _____ _____ _____ _____ _____
+  bytes   00010001 00000000 00000000 00000000 00000000
+  hex     11       00*      00*      00*      00*
+
+data     6 bytes
+hex      ff20a700fd11
+base64   /yCnAP0R
+ascii    . ....
+`, stdout)
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		code, stdout, stderr := execute("zwga-e07x", "inspect")
+
+		assert.Equal(t, exitOK, code, stderr)
+		assert.Contains(t, stdout, "mode     strict\n")
+		assert.NotContains(t, stdout, "header")
+		assert.Contains(t, stdout, "hex      ff20a700fd\n")
+	})
+
+	t.Run("non-canonical", func(t *testing.T) {
+		code, stdout, stderr := execute("4-zwga-e07x-2400-000z", "inspect")
+
+		assert.Equal(t, exitOK, code, stderr)
+		assert.Contains(t, stdout, "  padding          __ _____ _____ _____ _____ _____ !!!!!\n")
+		assert.Contains(t, stdout, "warning  non-zero trailing bits 'z' at offset 20\n")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		code, stdout, stderr := execute("4-zwga-e07u-2400-0000", "inspect")
+
+		assert.Equal(t, exitError, code)
+		assert.Contains(t, stdout, "  values  31    28    16    10    14    0     7     ?\n")
+		assert.Contains(t, stdout, "error    invalid character 'u' at offset 10\n")
+		assert.NotContains(t, stdout, "base64")
+		assert.Contains(t, stderr, "the token can not be decoded")
+	})
+
+	t.Run("color", func(t *testing.T) {
+		code, stdout, stderr := execute("4-zwga-e07x-2400-0000", "inspect", "--color")
+
+		assert.Equal(t, exitOK, code, stderr)
+		assert.Contains(t, stdout, "\x1b[36mz     \x1b[0m")
+		assert.Contains(t, stdout, "\x1b[33me     \x1b[0m")
+	})
+}
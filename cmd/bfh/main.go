@@ -0,0 +1,138 @@
+// Command bfh encodes and decodes binary data in the bfh format
+//
+// Usage:
+//
+//	bfh <command> [options] [file]
+//
+// Commands read the file given, or stdin if there is none or it is "-", and write to stdout.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+)
+
+const (
+	exitOK    = 0
+	exitError = 1
+	exitUsage = 2
+)
+
+// errUsage is returned when a command is called the wrong way, the problem itself is already reported
+var errUsage = errors.New("usage error")
+
+// streams holds the standard streams, so that commands can be tested
+type streams struct {
+	stdin  io.Reader
+	stdout io.Writer
+	stderr io.Writer
+}
+
+// command is a subcommand of bfh
+type command struct {
+	name    string
+	summary string
+	run     func(args []string, s streams) error
+}
+
+// commands lists the subcommands in the order they are listed in the usage
+var commands = []command{
+	{name: "encode", summary: "encode binary data into bfh text", run: runEncode},
+	{name: "decode", summary: "decode bfh text into binary data", run: runDecode},
+	{name: "validate", summary: "validate bfh tokens, one per line", run: runValidate},
+	{name: "inspect", summary: "explain how a bfh token is decoded, bit by bit", run: runInspect},
+	{name: "convert", summary: "convert between bfh, hex, base64 and base32", run: runConvert},
+	{name: "generate", summary: "generate unique random tokens", run: runGenerate},
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], streams{stdin: os.Stdin, stdout: os.Stdout, stderr: os.Stderr}))
+}
+
+// run executes the command named by the first argument and returns the exit code
+func run(args []string, s streams) int {
+	if len(args) == 0 {
+		usage(s.stderr)
+		return exitUsage
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+
+		err := cmd.run(args[1:], s)
+		switch {
+		case err == nil:
+			return exitOK
+		case errors.Is(err, errUsage):
+			return exitUsage
+		default:
+			fmt.Fprintf(s.stderr, "bfh %s: %v\n", cmd.name, err)
+			return exitError
+		}
+	}
+
+	fmt.Fprintf(s.stderr, "bfh: unknown command %q\n", args[0])
+	usage(s.stderr)
+
+	return exitUsage
+}
+
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "usage: bfh <command> [options] [file]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "commands:")
+
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-10s %s\n", cmd.name, cmd.summary)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, `Run "bfh <command> -h" for the options of a command.`)
+}
+
+// newFlagSet returns a flag set for a command, reporting problems to stderr
+func newFlagSet(name, args string, stderr io.Writer) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(stderr)
+	synopsis := "bfh " + name + " [options]"
+	if args != "" {
+		synopsis += " " + args
+	}
+
+	fs.Usage = func() {
+		fmt.Fprintf(stderr, "usage: %s\n\noptions:\n", synopsis)
+		fs.PrintDefaults()
+	}
+
+	return fs
+}
+
+// readInput reads the file named by the only argument, or stdin if there is none or it is "-"
+func readInput(fs *flag.FlagSet, stdin io.Reader) ([]byte, error) {
+	switch fs.NArg() {
+	case 0:
+		return ioutil.ReadAll(stdin)
+	case 1:
+		if fs.Arg(0) == "-" {
+			return ioutil.ReadAll(stdin)
+		}
+
+		return ioutil.ReadFile(fs.Arg(0))
+	default:
+		return nil, usageError(fs, "too many arguments")
+	}
+}
+
+// usageError reports a problem with the arguments along with the usage of the command
+func usageError(fs *flag.FlagSet, msg string) error {
+	fmt.Fprintf(fs.Output(), "bfh %s: %s\n", fs.Name(), msg)
+	fs.Usage()
+
+	return errUsage
+}
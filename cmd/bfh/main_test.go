@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tempDir returns a new temporary directory, the caller is responsible for removing it
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "bfh")
+	require.NoError(t, err)
+
+	return dir
+}
+
+// execute runs bfh with the arguments and stdin given, returning the exit code, stdout and stderr
+func execute(stdin string, args ...string) (int, string, string) {
+	var stdout, stderr bytes.Buffer
+
+	code := run(args, streams{stdin: strings.NewReader(stdin), stdout: &stdout, stderr: &stderr})
+
+	return code, stdout.String(), stderr.String()
+}
+
+func Test_Run(t *testing.T) {
+	t.Run("no command", func(t *testing.T) {
+		code, _, stderr := execute("")
+
+		assert.Equal(t, exitUsage, code)
+		assert.Contains(t, stderr, "usage: bfh")
+	})
+
+	t.Run("unknown command", func(t *testing.T) {
+		code, _, stderr := execute("", "frobnicate")
+
+		assert.Equal(t, exitUsage, code)
+		assert.Contains(t, stderr, `unknown command "frobnicate"`)
+	})
+
+	t.Run("help", func(t *testing.T) {
+		code, _, stderr := execute("", "encode", "-h")
+
+		assert.Equal(t, exitUsage, code)
+		assert.Contains(t, stderr, "usage: bfh encode")
+	})
+}
+
+func Test_Encode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Args           []string
+			Stdin          string
+			ExpectedStdout string
+		}{
+			{
+				Name:           "normal",
+				Args:           []string{"encode"},
+				Stdin:          "\xff\x20\xa7\x00\xfd\x11",
+				ExpectedStdout: "4-zwga-e07x-2400-0000\n",
+			},
+			{
+				Name:           "strict",
+				Args:           []string{"encode", "--strict"},
+				Stdin:          "\xff\x20\xa7\x00\xfd",
+				ExpectedStdout: "zwga-e07x\n",
+			},
+			{
+				Name:           "stdin as dash",
+				Args:           []string{"encode", "-strict", "-"},
+				Stdin:          "\xff\x20\xa7\x00\xfd",
+				ExpectedStdout: "zwga-e07x\n",
+			},
+			{
+				Name:           "no dashes",
+				Args:           []string{"encode", "--no-dashes"},
+				Stdin:          "\xff\x20\xa7\x00\xfd\x11",
+				ExpectedStdout: "4zwgae07x24000000\n",
+			},
+			{
+				Name:           "wrapped",
+				Args:           []string{"encode", "--wrap", "8"},
+				Stdin:          "\xff\x20\xa7\x00\xfd\x11",
+				ExpectedStdout: "4-zwga-e\n07x-2400\n-0000\n",
+			},
+			{
+				Name:           "empty",
+				Args:           []string{"encode"},
+				Stdin:          "",
+				ExpectedStdout: "0-\n",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				code, stdout, stderr := execute(tt.Stdin, tt.Args...)
+
+				assert.Equal(t, exitOK, code, stderr)
+				assert.Equal(t, tt.ExpectedStdout, stdout)
+			})
+		}
+	})
+
+	t.Run("file", func(t *testing.T) {
+		dir := tempDir(t)
+		defer os.RemoveAll(dir)
+
+		path := filepath.Join(dir, "data")
+		require.NoError(t, ioutil.WriteFile(path, []byte{255, 32, 167, 0, 253}, 0600))
+
+		code, stdout, stderr := execute("", "encode", "--strict", path)
+
+		assert.Equal(t, exitOK, code, stderr)
+		assert.Equal(t, "zwga-e07x\n", stdout)
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		dir := tempDir(t)
+		defer os.RemoveAll(dir)
+
+		tests := []struct {
+			Name         string
+			Args         []string
+			Stdin        string
+			ExpectedCode int
+		}{
+			{
+				Name:         "strict with invalid length",
+				Args:         []string{"encode", "--strict"},
+				Stdin:        "\xff",
+				ExpectedCode: exitError,
+			},
+			{
+				Name:         "missing file",
+				Args:         []string{"encode", filepath.Join(dir, "missing")},
+				ExpectedCode: exitError,
+			},
+			{
+				Name:         "negative wrap",
+				Args:         []string{"encode", "--wrap", "-1"},
+				ExpectedCode: exitUsage,
+			},
+			{
+				Name:         "too many arguments",
+				Args:         []string{"encode", "a", "b"},
+				ExpectedCode: exitUsage,
+			},
+			{
+				Name:         "unknown flag",
+				Args:         []string{"encode", "--frobnicate"},
+				ExpectedCode: exitUsage,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				code, stdout, stderr := execute(tt.Stdin, tt.Args...)
+
+				assert.Equal(t, tt.ExpectedCode, code)
+				assert.Empty(t, stdout)
+				assert.NotEmpty(t, stderr)
+			})
+		}
+	})
+}
+
+func Test_Decode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Args           []string
+			Stdin          string
+			ExpectedStdout string
+		}{
+			{
+				Name:           "normal",
+				Args:           []string{"decode"},
+				Stdin:          "4-zwga-e07x-2400-0000\n",
+				ExpectedStdout: "\xff\x20\xa7\x00\xfd\x11",
+			},
+			{
+				Name:           "hex",
+				Args:           []string{"decode", "--hex"},
+				Stdin:          "4-zwga-e07x-2400-0000",
+				ExpectedStdout: "ff20a700fd11\n",
+			},
+			{
+				Name:           "strict",
+				Args:           []string{"decode", "--strict", "--hex"},
+				Stdin:          "zwga-e07x",
+				ExpectedStdout: "ff20a700fd\n",
+			},
+			{
+				Name:           "auto",
+				Args:           []string{"decode", "--auto", "--hex"},
+				Stdin:          "h4-zwga-e07x-2400-0000",
+				ExpectedStdout: "ff20a700fd11\n",
+			},
+			{
+				Name:           "wrapped without dashes",
+				Args:           []string{"decode", "--hex"},
+				Stdin:          "4zwgae07\nx24000000\n",
+				ExpectedStdout: "ff20a700fd11\n",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				code, stdout, stderr := execute(tt.Stdin, tt.Args...)
+
+				assert.Equal(t, exitOK, code, stderr)
+				assert.Equal(t, tt.ExpectedStdout, stdout)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name         string
+			Args         []string
+			Stdin        string
+			ExpectedCode int
+		}{
+			{
+				Name:         "invalid character",
+				Args:         []string{"decode"},
+				Stdin:        "4-zwga-e07u-2400-0000",
+				ExpectedCode: exitError,
+			},
+			{
+				Name:         "strict with normal input",
+				Args:         []string{"decode", "--strict"},
+				Stdin:        "4-zwga-e07x-2400-0000",
+				ExpectedCode: exitError,
+			},
+			{
+				Name:         "strict and auto",
+				Args:         []string{"decode", "--strict", "--auto"},
+				Stdin:        "zwga-e07x",
+				ExpectedCode: exitUsage,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				code, stdout, stderr := execute(tt.Stdin, tt.Args...)
+
+				assert.Equal(t, tt.ExpectedCode, code)
+				assert.Empty(t, stdout)
+				assert.NotEmpty(t, stderr)
+			})
+		}
+	})
+}
+
+func Test_Encode_Decode(t *testing.T) {
+	data := "\x00\x01\x02binary\xfe\xff"
+
+	code, encoded, _ := execute(data, "encode", "--wrap", "5", "--no-dashes")
+	require.Equal(t, exitOK, code)
+
+	code, decoded, _ := execute(encoded, "decode")
+	require.Equal(t, exitOK, code)
+
+	assert.Equal(t, data, decoded)
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	bfh "github.com/peteraba/binary4humans"
+)
+
+// validators maps the values of the mode option to the validators backing them
+var validators = map[string]func(string) error{
+	"well-formatted": bfh.ValidateWellFormatted,
+	"acceptable":     bfh.Validate,
+	"strict":         bfh.ValidateStrict,
+}
+
+// rules names the kinds of problems in a stable, machine-readable way
+var rules = []struct {
+	err  error
+	name string
+}{
+	{err: bfh.ErrInvalidCharacter, name: "invalid-character"},
+	{err: bfh.ErrInvalidPadding, name: "invalid-padding"},
+	{err: bfh.ErrInvalidLength, name: "invalid-length"},
+	{err: bfh.ErrNonCanonical, name: "non-canonical"},
+}
+
+// validation is the result of validating a single token
+type validation struct {
+	Line   int    `json:"line"`
+	Token  string `json:"token"`
+	Valid  bool   `json:"valid"`
+	Rule   string `json:"rule,omitempty"`
+	Offset *int   `json:"offset,omitempty"`
+	Error  string `json:"error,omitempty"`
+}
+
+func runValidate(args []string, s streams) error {
+	fs := newFlagSet("validate", "[file]", s.stderr)
+	mode := fs.String("mode", "well-formatted", "the check to apply: well-formatted, acceptable or strict")
+	asJSON := fs.Bool("json", false, "write one JSON object per token instead of text")
+
+	if err := fs.Parse(args); err != nil {
+		// the flag package has already reported the problem
+		return errUsage
+	}
+
+	validate, ok := validators[*mode]
+	if !ok {
+		return usageError(fs, fmt.Sprintf("unknown mode %q", *mode))
+	}
+
+	input, err := readInput(fs, s.stdin)
+	if err != nil {
+		return err
+	}
+
+	enc := json.NewEncoder(s.stdout)
+
+	total, invalid := 0, 0
+	scanner := bufio.NewScanner(bytes.NewReader(input))
+
+	for line := 1; scanner.Scan(); line++ {
+		// lines are tokens as they are, except for the line ending
+		token := string(bytes.TrimSuffix(scanner.Bytes(), []byte{'\r'}))
+		if token == "" {
+			continue
+		}
+
+		v := newValidation(line, token, validate(token))
+
+		total++
+		if !v.Valid {
+			invalid++
+		}
+
+		if *asJSON {
+			err = enc.Encode(v)
+		} else {
+			err = v.writeText(s.stdout)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+
+	if invalid > 0 {
+		return fmt.Errorf("%d of %d tokens are invalid", invalid, total)
+	}
+
+	return nil
+}
+
+// newValidation describes the result of validating a token
+func newValidation(line int, token string, err error) validation {
+	v := validation{Line: line, Token: token, Valid: err == nil}
+	if err == nil {
+		return v
+	}
+
+	v.Error = err.Error()
+
+	var corruptInputErr *bfh.CorruptInputError
+	if errors.As(err, &corruptInputErr) {
+		v.Offset = &corruptInputErr.Offset
+	}
+
+	for _, rule := range rules {
+		if errors.Is(err, rule.err) {
+			v.Rule = rule.name
+			break
+		}
+	}
+
+	return v
+}
+
+// writeText writes the result as a line of text
+func (v validation) writeText(w io.Writer) error {
+	if v.Valid {
+		_, err := fmt.Fprintf(w, "line %d: %s: valid\n", v.Line, v.Token)
+		return err
+	}
+
+	_, err := fmt.Fprintf(w, "line %d: %s: %s\n", v.Line, v.Token, v.Error)
+
+	return err
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Validate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Args           []string
+			Stdin          string
+			ExpectedStdout string
+		}{
+			{
+				Name:           "well-formatted",
+				Args:           []string{"validate"},
+				Stdin:          "4-zwga-e07x-2400-0000\n\n0-zwga-e07x\r\n",
+				ExpectedStdout: "line 1: 4-zwga-e07x-2400-0000: valid\nline 3: 0-zwga-e07x: valid\n",
+			},
+			{
+				Name:           "acceptable",
+				Args:           []string{"validate", "--mode", "acceptable"},
+				Stdin:          "4zwgae07x24000000",
+				ExpectedStdout: "line 1: 4zwgae07x24000000: valid\n",
+			},
+			{
+				Name:           "strict",
+				Args:           []string{"validate", "--mode=strict"},
+				Stdin:          "zwga-e07x\n",
+				ExpectedStdout: "line 1: zwga-e07x: valid\n",
+			},
+			{
+				Name:           "json",
+				Args:           []string{"validate", "--json"},
+				Stdin:          "0-zwga-e07x\n",
+				ExpectedStdout: `{"line":1,"token":"0-zwga-e07x","valid":true}` + "\n",
+			},
+			{
+				Name:           "empty",
+				Args:           []string{"validate"},
+				Stdin:          "",
+				ExpectedStdout: "",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				code, stdout, stderr := execute(tt.Stdin, tt.Args...)
+
+				assert.Equal(t, exitOK, code, stderr)
+				assert.Equal(t, tt.ExpectedStdout, stdout)
+			})
+		}
+	})
+
+	t.Run("invalid tokens", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Args           []string
+			Stdin          string
+			ExpectedStdout string
+			ExpectedStderr string
+		}{
+			{
+				Name:  "text",
+				Args:  []string{"validate"},
+				Stdin: "4-zwga-e07x-2400-0000\n4-zwga-e07u-2400-0000\n4zwgae07x24000000\n",
+				ExpectedStdout: "line 1: 4-zwga-e07x-2400-0000: valid\n" +
+					"line 2: 4-zwga-e07u-2400-0000: invalid character 'u' at offset 10\n" +
+					"line 3: 4zwgae07x24000000: invalid character 'z' at offset 1\n",
+				ExpectedStderr: "2 of 3 tokens are invalid",
+			},
+			{
+				Name:  "json",
+				Args:  []string{"validate", "--json", "--mode", "strict"},
+				Stdin: "zwga-e07\n0-zwga-e07x\n",
+				ExpectedStdout: `{"line":1,"token":"zwga-e07","valid":false,"rule":"invalid-length","offset":8,"error":"invalid length at offset 8"}` + "\n" +
+					`{"line":2,"token":"0-zwga-e07x","valid":false,"rule":"invalid-character","offset":1,"error":"invalid character '-' at offset 1"}` + "\n",
+				ExpectedStderr: "2 of 2 tokens are invalid",
+			},
+			{
+				Name:           "non-canonical",
+				Args:           []string{"validate", "--json", "--mode", "acceptable"},
+				Stdin:          "4-zwga-e07x-2400-000z\n",
+				ExpectedStdout: `{"line":1,"token":"4-zwga-e07x-2400-000z","valid":false,"rule":"non-canonical","offset":20,"error":"non-zero trailing bits 'z' at offset 20"}` + "\n",
+				ExpectedStderr: "1 of 1 tokens are invalid",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				code, stdout, stderr := execute(tt.Stdin, tt.Args...)
+
+				assert.Equal(t, exitError, code)
+				assert.Equal(t, tt.ExpectedStdout, stdout)
+				assert.True(t, strings.Contains(stderr, tt.ExpectedStderr), stderr)
+			})
+		}
+	})
+
+	t.Run("unknown mode", func(t *testing.T) {
+		code, stdout, stderr := execute("0-zwga-e07x", "validate", "--mode", "lenient")
+
+		assert.Equal(t, exitUsage, code)
+		assert.Empty(t, stdout)
+		assert.Contains(t, stderr, `unknown mode "lenient"`)
+	})
+}
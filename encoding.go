@@ -0,0 +1,209 @@
+package bfh
+
+import (
+	"errors"
+	"strings"
+)
+
+const (
+	errMsgAlphabetLength            = "alphabet must be exactly 32 characters long"
+	errMsgAlphabetNotASCII          = "alphabet must only contain ASCII characters"
+	errMsgAlphabetDuplicate         = "alphabet must not contain duplicate characters"
+	errMsgAlphabetContainsSeparator = "alphabet must not contain the separator"
+	errMsgSeparatorNotASCII         = "separator must be an ASCII character"
+	errMsgGroupLengthNegative       = "group length must not be negative"
+	errMsgCheckSymbolsLength        = "check symbols must be exactly 5 characters long"
+	errMsgCheckSymbolsInvalid       = "check symbols must be distinct ASCII characters not used by the alphabet or as separator"
+
+	// invalidDigit marks bytes not part of the alphabet in the decode map
+	invalidDigit = 0xff
+	// defaultGroupLength is the number of characters displayed between two separators by default
+	defaultGroupLength = 4
+)
+
+// StdEncoding is the encoding used by the package level functions
+// For backwards compatibility it is not canonical, use CanonicalEncoding to reject malleable encodings.
+var StdEncoding = MustNewEncoding(digits, WithCanonical(false))
+
+// CanonicalEncoding is the same as StdEncoding, except that it only decodes the one canonical form of any data
+var CanonicalEncoding = MustNewEncoding(digits)
+
+// Encoding is a bfh encoding defined by its alphabet, grouping and separator
+// Encodings are safe for concurrent use after construction.
+type Encoding struct {
+	alphabet      [32]byte
+	decodeMap     [256]byte
+	groupLength   int
+	separator     byte
+	paddingHeader bool
+	checkSymbols  string
+	canonical     bool
+}
+
+// Option is used to customize an Encoding created by NewEncoding
+type Option func(*Encoding)
+
+// WithGroupLength sets the number of characters between two separators, zero means no grouping at all
+// The default group length is 4.
+func WithGroupLength(n int) Option {
+	return func(enc *Encoding) {
+		enc.groupLength = n
+	}
+}
+
+// WithSeparator sets the byte used to separate groups of characters
+// The default separator is '-'.
+func WithSeparator(sep byte) Option {
+	return func(enc *Encoding) {
+		enc.separator = sep
+	}
+}
+
+// WithPaddingHeader sets whether normal mode starts with the padding digit
+// Without the padding header Encode and Decode behave as EncodeStrict and DecodeStrict, meaning that only data with a
+// length dividable by 5 can be encoded. The padding header is used by default.
+func WithPaddingHeader(enabled bool) Option {
+	return func(enc *Encoding) {
+		enc.paddingHeader = enabled
+	}
+}
+
+// WithCheckSymbols sets the 5 extra symbols used as check symbols for the values 32 to 36
+// The default check symbols are "*~$=u". If the default symbols collide with a custom alphabet or separator the checked
+// functions of the encoding will not be available unless other check symbols are provided.
+func WithCheckSymbols(symbols string) Option {
+	return func(enc *Encoding) {
+		enc.checkSymbols = symbols
+	}
+}
+
+// WithCanonical sets whether decoding rejects encodings with non-zero padding bits
+// Without it several strings decode to the same data, as the bits not representing any data are simply ignored, which is
+// dangerous when the encoded form is used as a key or compared as a token. Encodings are canonical by default.
+func WithCanonical(enabled bool) Option {
+	return func(enc *Encoding) {
+		enc.canonical = enabled
+	}
+}
+
+// NewEncoding returns a new Encoding using the 32 characters of the given alphabet
+// The alphabet must only contain ASCII characters, must not contain duplicates or the separator.
+func NewEncoding(alphabet string, opts ...Option) (*Encoding, error) {
+	if len(alphabet) != len(digits) {
+		return nil, errors.New(errMsgAlphabetLength)
+	}
+
+	enc := &Encoding{
+		groupLength:   defaultGroupLength,
+		separator:     separator,
+		paddingHeader: true,
+		checkSymbols:  defaultCheckSymbols,
+		canonical:     true,
+	}
+
+	for _, opt := range opts {
+		opt(enc)
+	}
+
+	if enc.groupLength < 0 {
+		return nil, errors.New(errMsgGroupLengthNegative)
+	}
+
+	if enc.separator >= 0x80 {
+		return nil, errors.New(errMsgSeparatorNotASCII)
+	}
+
+	for i := range enc.decodeMap {
+		enc.decodeMap[i] = invalidDigit
+	}
+
+	for i := 0; i < len(alphabet); i++ {
+		ch := alphabet[i]
+
+		switch {
+		case ch >= 0x80:
+			return nil, errors.New(errMsgAlphabetNotASCII)
+		case ch == enc.separator:
+			return nil, errors.New(errMsgAlphabetContainsSeparator)
+		case enc.decodeMap[ch] != invalidDigit:
+			return nil, errors.New(errMsgAlphabetDuplicate)
+		}
+
+		enc.alphabet[i] = ch
+		enc.decodeMap[ch] = byte(i)
+	}
+
+	if err := enc.validateCheckSymbols(); err != nil {
+		if enc.checkSymbols != defaultCheckSymbols {
+			return nil, err
+		}
+
+		// the default check symbols are simply not available for this alphabet
+		enc.checkSymbols = ""
+	}
+
+	return enc, nil
+}
+
+// validateCheckSymbols ensures that check symbols can not be mistaken for digits, separators or each other
+func (enc *Encoding) validateCheckSymbols() error {
+	if len(enc.checkSymbols) != checkModulus-len(enc.alphabet) {
+		return errors.New(errMsgCheckSymbolsLength)
+	}
+
+	for i := 0; i < len(enc.checkSymbols); i++ {
+		ch := enc.checkSymbols[i]
+
+		if ch >= 0x80 || ch == enc.separator || enc.decodeMap[ch] != invalidDigit {
+			return errors.New(errMsgCheckSymbolsInvalid)
+		}
+
+		if strings.IndexByte(enc.checkSymbols[:i], ch) >= 0 {
+			return errors.New(errMsgCheckSymbolsInvalid)
+		}
+	}
+
+	return nil
+}
+
+// MustNewEncoding is like NewEncoding but panics if the encoding can not be created
+func MustNewEncoding(alphabet string, opts ...Option) *Encoding {
+	enc, err := NewEncoding(alphabet, opts...)
+	if err != nil {
+		panic(err)
+	}
+
+	return enc
+}
+
+// getDigit returns the value of a character of the alphabet
+func (enc *Encoding) getDigit(r uint8) (byte, error) {
+	value := enc.decodeMap[r]
+	if value == invalidDigit {
+		return 0, ErrInvalidCharacter
+	}
+
+	return value, nil
+}
+
+// groupedLength returns the length of charCount characters after adding the separators
+func (enc *Encoding) groupedLength(charCount int) int {
+	if enc.groupLength == 0 || charCount == 0 {
+		return charCount
+	}
+
+	return charCount + (charCount-1)/enc.groupLength
+}
+
+// headerLength returns the number of characters used by the padding header including its separator
+func (enc *Encoding) headerLength() int {
+	if !enc.paddingHeader {
+		return 0
+	}
+
+	if enc.groupLength == 0 {
+		return 1
+	}
+
+	return 2
+}
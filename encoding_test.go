@@ -0,0 +1,275 @@
+package bfh
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const base32HexLower = "0123456789abcdefghijklmnopqrstuv"
+
+func Test_NewEncoding(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name     string
+			Alphabet string
+			Options  []Option
+		}{
+			{
+				Name:     "default",
+				Alphabet: digits,
+			},
+			{
+				Name:     "base32hex",
+				Alphabet: base32HexLower,
+			},
+			{
+				Name:     "no grouping",
+				Alphabet: digits,
+				Options:  []Option{WithGroupLength(0)},
+			},
+			{
+				Name:     "space separator",
+				Alphabet: digits,
+				Options:  []Option{WithSeparator(' ')},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				enc, err := NewEncoding(tt.Alphabet, tt.Options...)
+
+				assert.NoError(t, err)
+				assert.NotNil(t, enc)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name     string
+			Alphabet string
+			Options  []Option
+		}{
+			{
+				Name:     "too short",
+				Alphabet: digits[1:],
+			},
+			{
+				Name:     "too long",
+				Alphabet: digits + "u",
+			},
+			{
+				Name:     "duplicate",
+				Alphabet: "0123456789abcdefghjkmnpqrstvwxy0",
+			},
+			{
+				Name:     "non-ascii",
+				Alphabet: "0123456789abcdefghjkmnpqrstvwx\xc3\xbc",
+			},
+			{
+				Name:     "contains separator",
+				Alphabet: "0123456789abcdefghjkmnpqrstvwxy-",
+			},
+			{
+				Name:     "contains custom separator",
+				Alphabet: digits,
+				Options:  []Option{WithSeparator('z')},
+			},
+			{
+				Name:     "non-ascii separator",
+				Alphabet: digits,
+				Options:  []Option{WithSeparator(0xa0)},
+			},
+			{
+				Name:     "negative group length",
+				Alphabet: digits,
+				Options:  []Option{WithGroupLength(-1)},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := NewEncoding(tt.Alphabet, tt.Options...)
+
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func Test_MustNewEncoding(t *testing.T) {
+	assert.Panics(t, func() {
+		MustNewEncoding(digits[1:])
+	})
+}
+
+func Test_Encoding_EncodeStr(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Encoding       *Encoding
+		Bytes          []byte
+		ExpectedResult string
+	}{
+		{
+			Name:           "base32hex",
+			Encoding:       MustNewEncoding(base32HexLower),
+			Bytes:          []byte{255, 32, 167, 0, 253, 17},
+			ExpectedResult: "4-vsga-e07t-2400-0000",
+		},
+		{
+			Name:           "no grouping",
+			Encoding:       MustNewEncoding(digits, WithGroupLength(0)),
+			Bytes:          []byte{255, 32, 167, 0, 253, 17},
+			ExpectedResult: "4zwgae07x24000000",
+		},
+		{
+			Name:           "no grouping empty",
+			Encoding:       MustNewEncoding(digits, WithGroupLength(0)),
+			Bytes:          []byte{},
+			ExpectedResult: "0",
+		},
+		{
+			Name:           "group length 5",
+			Encoding:       MustNewEncoding(digits, WithGroupLength(5)),
+			Bytes:          []byte{255, 32, 167, 0, 253, 17},
+			ExpectedResult: "4-zwgae-07x24-00000-0",
+		},
+		{
+			Name:           "custom separator",
+			Encoding:       MustNewEncoding(digits, WithSeparator(' ')),
+			Bytes:          []byte{255, 32, 167, 0, 253, 17},
+			ExpectedResult: "4 zwga e07x 2400 0000",
+		},
+		{
+			Name:           "no padding header",
+			Encoding:       MustNewEncoding(digits, WithPaddingHeader(false)),
+			Bytes:          []byte{255, 32, 167, 0, 253},
+			ExpectedResult: "zwga-e07x",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			actualResult, err := tt.Encoding.EncodeStr(tt.Bytes)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.ExpectedResult, actualResult)
+			assert.True(t, tt.Encoding.IsWellFormatted(actualResult), fmt.Sprintf("Failing value: %s", actualResult))
+			assert.True(t, tt.Encoding.IsAcceptable(actualResult), fmt.Sprintf("Failing value: %s", actualResult))
+
+			decoded, err := tt.Encoding.DecodeStr(actualResult)
+			require.NoError(t, err)
+
+			assert.Equal(t, tt.Bytes, decoded)
+		})
+	}
+
+	t.Run("fail without padding header on wrong length", func(t *testing.T) {
+		_, err := MustNewEncoding(digits, WithPaddingHeader(false)).EncodeStr([]byte{1, 2, 3})
+
+		assert.Error(t, err)
+	})
+}
+
+func Test_Encoding_RoundTrip(t *testing.T) {
+	encodings := map[string]*Encoding{
+		"base32hex":         MustNewEncoding(base32HexLower),
+		"no grouping":       MustNewEncoding(digits, WithGroupLength(0)),
+		"group length 3":    MustNewEncoding(digits, WithGroupLength(3)),
+		"group length 8":    MustNewEncoding(digits, WithGroupLength(8), WithSeparator('_')),
+		"no padding header": MustNewEncoding(digits, WithPaddingHeader(false)),
+	}
+
+	for name, enc := range encodings {
+		enc := enc
+
+		t.Run(name, func(t *testing.T) {
+			for _, length := range []int{0, 5, 37, 120, 141} {
+				b := make([]byte, length)
+
+				_, err := rand.Read(b)
+				require.NoError(t, err)
+
+				if enc.paddingHeader {
+					encoded, err := enc.EncodeStr(b)
+					require.NoError(t, err)
+
+					assert.True(t, enc.IsWellFormatted(encoded), fmt.Sprintf("Failing value: %s", encoded))
+					assert.True(t, enc.IsAcceptable(encoded), fmt.Sprintf("Failing value: %s", encoded))
+
+					decoded, err := enc.DecodeStr(encoded)
+					require.NoError(t, err)
+					assert.Equal(t, b, decoded)
+				}
+
+				if length%5 != 0 {
+					continue
+				}
+
+				encoded, err := enc.EncodeStrictStr(b)
+				require.NoError(t, err)
+
+				assert.True(t, enc.IsStrict(encoded), fmt.Sprintf("Failing value: %s", encoded))
+
+				decoded, err := enc.DecodeStrictStr(encoded)
+				require.NoError(t, err)
+				assert.Equal(t, b, decoded)
+
+				buf := &bytes.Buffer{}
+				w := enc.NewEncoder(buf)
+				_, err = w.Write(b)
+				require.NoError(t, err)
+				require.NoError(t, w.Close())
+
+				decoded, err = ioutil.ReadAll(enc.NewDecoder(buf))
+				require.NoError(t, err)
+				assert.Equal(t, b, decoded)
+			}
+		})
+	}
+}
+
+func Test_Encoding_IsWellFormatted(t *testing.T) {
+	enc := MustNewEncoding(digits, WithGroupLength(5))
+
+	tests := []struct {
+		Name           string
+		String         string
+		ExpectedResult bool
+	}{
+		{
+			Name:           "valid",
+			String:         "4-zwgae-07x24-00000-0",
+			ExpectedResult: true,
+		},
+		{
+			Name:           "default grouping",
+			String:         "4-zwga-e07x-2400-0000",
+			ExpectedResult: false,
+		},
+		{
+			Name:           "missing header separator",
+			String:         "40zwgae-07x24-00000-0",
+			ExpectedResult: false,
+		},
+		{
+			Name:           "non-zero padding",
+			String:         "4-zwgae-07x24-00000-1",
+			ExpectedResult: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			actualResult := enc.IsWellFormatted(tt.String)
+
+			assert.Equal(t, tt.ExpectedResult, actualResult, fmt.Sprintf("Failing value: %s", tt.String))
+		})
+	}
+}
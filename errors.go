@@ -0,0 +1,61 @@
+package bfh
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+var (
+	// ErrNilInput is returned when nil is passed instead of binary data
+	ErrNilInput = errors.New("binary data must not be nil")
+	// ErrInvalidCharacter is returned when the input contains a character which is neither part of the alphabet nor a
+	// separator at the right position
+	ErrInvalidCharacter = errors.New("invalid character")
+	// ErrInvalidPadding is returned when the padding digit is not 0, 1, 2, 3 or 4 or it does not match the data
+	ErrInvalidPadding = errors.New("invalid padding digit")
+	// ErrInvalidLength is returned when the length of the input can not be encoded or decoded
+	ErrInvalidLength = errors.New("invalid length")
+	// ErrNonCanonical is returned when the bits not representing any data are not all zeros
+	ErrNonCanonical = errors.New("non-zero trailing bits")
+	// ErrOverflow is returned when a number does not fit into the type it is decoded into or the width it is encoded in
+	ErrOverflow = errors.New("number out of range")
+	// ErrUnknownFormat is returned when the header of a self-describing string is not known
+	ErrUnknownFormat = errors.New("unknown format")
+	// ErrChecksumMismatch is returned when the check symbol of an encoded string does not match the decoded data
+	ErrChecksumMismatch = errors.New("check symbol does not match the encoded data")
+)
+
+// CorruptInputError describes a problem at a specific position of an encoded string
+// Err is always one of the package level errors, therefore errors.Is can be used to check the kind of the problem.
+type CorruptInputError struct {
+	// Offset is the byte offset of the problem in the original input
+	Offset int
+	// Rune is the offending rune, 0 if the input ended unexpectedly
+	Rune rune
+	// Err is the kind of the problem
+	Err error
+}
+
+func newCorruptInputError(str string, offset int, err error) *CorruptInputError {
+	var r rune
+	if offset < len(str) {
+		r, _ = utf8.DecodeRuneInString(str[offset:])
+	}
+
+	return &CorruptInputError{Offset: offset, Rune: r, Err: err}
+}
+
+// Error returns the description of the problem including its position
+func (e *CorruptInputError) Error() string {
+	if e.Rune == 0 {
+		return fmt.Sprintf("%s at offset %d", e.Err, e.Offset)
+	}
+
+	return fmt.Sprintf("%s %q at offset %d", e.Err, e.Rune, e.Offset)
+}
+
+// Unwrap returns the kind of the problem
+func (e *CorruptInputError) Unwrap() error {
+	return e.Err
+}
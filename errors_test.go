@@ -0,0 +1,170 @@
+package bfh
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CorruptInputError(t *testing.T) {
+	t.Run("error message", func(t *testing.T) {
+		tests := []struct {
+			Name            string
+			Err             *CorruptInputError
+			ExpectedMessage string
+		}{
+			{
+				Name:            "with rune",
+				Err:             &CorruptInputError{Offset: 2, Rune: 'u', Err: ErrInvalidCharacter},
+				ExpectedMessage: "invalid character 'u' at offset 2",
+			},
+			{
+				Name:            "end of input",
+				Err:             &CorruptInputError{Offset: 9, Err: ErrInvalidLength},
+				ExpectedMessage: "invalid length at offset 9",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				assert.EqualError(t, tt.Err, tt.ExpectedMessage)
+				assert.True(t, errors.Is(tt.Err, tt.Err.Err))
+			})
+		}
+	})
+
+	t.Run("new error at offset", func(t *testing.T) {
+		tests := []struct {
+			Name         string
+			String       string
+			Offset       int
+			ExpectedRune rune
+		}{
+			{
+				Name:         "ascii",
+				String:       "4-uwga",
+				Offset:       2,
+				ExpectedRune: 'u',
+			},
+			{
+				Name:         "multi-byte",
+				String:       "4-ö",
+				Offset:       2,
+				ExpectedRune: 'ö',
+			},
+			{
+				Name:         "past the end",
+				String:       "4-zwga",
+				Offset:       6,
+				ExpectedRune: 0,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				err := newCorruptInputError(tt.String, tt.Offset, ErrInvalidCharacter)
+
+				assert.Equal(t, tt.Offset, err.Offset)
+				assert.Equal(t, tt.ExpectedRune, err.Rune)
+			})
+		}
+	})
+}
+
+func Test_DecodeErrors(t *testing.T) {
+	tests := []struct {
+		Name           string
+		String         string
+		Decode         func(string) ([]byte, error)
+		ExpectedErr    error
+		ExpectedOffset int
+		ExpectedRune   rune
+	}{
+		{
+			Name:           "invalid character",
+			String:         "4-ouga-e07x-2400-0000",
+			Decode:         DecodeStr,
+			ExpectedErr:    ErrInvalidCharacter,
+			ExpectedOffset: 2,
+			ExpectedRune:   'o',
+		},
+		{
+			Name:           "invalid header",
+			String:         "u-zwga-e07x-2400-0000",
+			Decode:         DecodeStr,
+			ExpectedErr:    ErrInvalidCharacter,
+			ExpectedOffset: 0,
+			ExpectedRune:   'u',
+		},
+		{
+			Name:           "padding above 4",
+			String:         "5-zwga-e07x-2400-0000",
+			Decode:         DecodeStr,
+			ExpectedErr:    ErrInvalidPadding,
+			ExpectedOffset: 0,
+			ExpectedRune:   '5',
+		},
+		{
+			Name:           "invalid length",
+			String:         "4-zwga-e07x-2400",
+			Decode:         DecodeStr,
+			ExpectedErr:    ErrInvalidLength,
+			ExpectedOffset: 16,
+		},
+		{
+			Name:           "empty",
+			String:         "",
+			Decode:         DecodeStr,
+			ExpectedErr:    ErrInvalidLength,
+			ExpectedOffset: 0,
+		},
+		{
+			Name:           "separators only",
+			String:         "--",
+			Decode:         DecodeStr,
+			ExpectedErr:    ErrInvalidLength,
+			ExpectedOffset: 2,
+		},
+		{
+			Name:           "strict invalid character",
+			String:         "zwga-e07x-24u0-0000",
+			Decode:         DecodeStrictStr,
+			ExpectedErr:    ErrInvalidCharacter,
+			ExpectedOffset: 12,
+			ExpectedRune:   'u',
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			_, err := tt.Decode(tt.String)
+
+			require.Error(t, err, fmt.Sprintf("Failing value: %s", tt.String))
+			assert.ErrorIs(t, err, tt.ExpectedErr)
+
+			var corruptErr *CorruptInputError
+			require.ErrorAs(t, err, &corruptErr)
+			assert.Equal(t, tt.ExpectedOffset, corruptErr.Offset)
+			assert.Equal(t, tt.ExpectedRune, corruptErr.Rune)
+		})
+	}
+}
+
+func Test_EncodeErrors(t *testing.T) {
+	t.Run("nil input", func(t *testing.T) {
+		_, err := EncodeStr(nil)
+		assert.ErrorIs(t, err, ErrNilInput)
+
+		_, err = EncodeStrictStr(nil)
+		assert.ErrorIs(t, err, ErrNilInput)
+	})
+
+	t.Run("strict length", func(t *testing.T) {
+		_, err := EncodeStrictStr([]byte{1, 2, 3})
+
+		assert.ErrorIs(t, err, ErrInvalidLength)
+	})
+}
@@ -0,0 +1,175 @@
+package bfh
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Report describes how an encoded string is decoded, down to the bits of each character
+type Report struct {
+	// Input is the string explained
+	Input string
+	// Strict is true if the string has no padding header
+	Strict bool
+	// Header is the padding header, nil in strict mode
+	Header *CharReport
+	// Padding is the number of padding bytes as given by the header
+	Padding int
+	// Packets are the blocks of 8 characters representing 5 bytes each, the last one is incomplete if the length is
+	// invalid
+	Packets []PacketReport
+	// Data is the decoded data, nil if the string can not be decoded
+	Data []byte
+	// Err is the reason why the string can not be decoded
+	Err error
+}
+
+// PacketReport describes a block of 8 characters and the 5 bytes they represent
+type PacketReport struct {
+	// Chars are the characters of the packet, separators excluded
+	Chars []CharReport
+	// Bytes are the bytes fully represented by Chars
+	Bytes []ByteReport
+}
+
+// CharReport describes a single character and the 5 bits it represents
+type CharReport struct {
+	// Offset is the byte offset of the character in the input
+	Offset int
+	// Rune is the character itself
+	Rune rune
+	// Value is the 5-bit value of the character, 0 if it is not part of the alphabet
+	Value byte
+	// Valid is true if the character is part of the alphabet
+	Valid bool
+	// Group is the index of the group the character belongs to, counting from the first packet
+	Group int
+	// PaddingMask selects the bits of Value only representing padding
+	PaddingMask byte
+}
+
+// ByteReport describes a byte and the characters representing it
+type ByteReport struct {
+	// Value is the byte itself
+	Value byte
+	// FirstChar and LastChar are the indexes of the first and last characters of the packet holding its bits
+	FirstChar int
+	LastChar  int
+	// Padding is true if the byte was only added to fill the last packet
+	Padding bool
+}
+
+// Bits returns the 5-bit value of the character as binary digits
+func (c CharReport) Bits() string {
+	return fmt.Sprintf("%05b", c.Value)
+}
+
+// NonCanonical returns true if any of the bits only representing padding is not zero
+func (c CharReport) NonCanonical() bool {
+	return c.Value&c.PaddingMask != 0
+}
+
+// Explain breaks an encoded string into its padding header, packets and groups, describing the bits of each character
+// and the bytes they make up
+// Strings of some multiple of 8 digits are explained in strict mode, any other in normal mode. The report is returned
+// even if the string can not be decoded, Report.Err being the same as the error returned.
+func Explain(str string) (*Report, error) {
+	return StdEncoding.Explain(str)
+}
+
+// Explain breaks an encoded string into its padding header, packets and groups, describing the bits of each character
+// and the bytes they make up
+func (enc *Encoding) Explain(str string) (*Report, error) {
+	report := &Report{Input: str}
+
+	chars := enc.explainChars(str)
+
+	report.Strict = !enc.paddingHeader || len(chars)%8 == 0
+	if !report.Strict {
+		report.Header = &chars[0]
+		chars = chars[1:]
+
+		if report.Header.Valid && report.Header.Value <= 4 {
+			report.Padding = int(report.Header.Value)
+		}
+	}
+
+	for i := range chars {
+		if enc.groupLength > 0 {
+			chars[i].Group = i / enc.groupLength
+		}
+	}
+
+	for offset := 0; offset < len(chars); offset += 8 {
+		end := offset + 8
+		if end > len(chars) {
+			end = len(chars)
+		}
+
+		padding := 0
+		if end == len(chars) {
+			padding = report.Padding
+		}
+
+		report.Packets = append(report.Packets, explainPacket(chars[offset:end], padding))
+	}
+
+	if report.Strict {
+		report.Data, report.Err = enc.DecodeStrictStr(str)
+	} else {
+		report.Data, report.Err = enc.DecodeStr(str)
+	}
+
+	return report, report.Err
+}
+
+// explainChars returns the characters of str, separators excluded
+func (enc *Encoding) explainChars(str string) []CharReport {
+	chars := make([]CharReport, 0, len(str))
+
+	for offset, r := range str {
+		if r == rune(enc.separator) {
+			continue
+		}
+
+		c := CharReport{Offset: offset, Rune: r}
+		if r < utf8.RuneSelf && enc.decodeMap[r] != invalidDigit {
+			c.Value = enc.decodeMap[r]
+			c.Valid = true
+		}
+
+		chars = append(chars, c)
+	}
+
+	return chars
+}
+
+// explainPacket describes the bytes represented by the characters of a packet, the last padding bytes being padding
+func explainPacket(chars []CharReport, padding int) PacketReport {
+	var word uint64
+	for i, c := range chars {
+		word |= uint64(c.Value) << uint(35-5*i)
+	}
+
+	// bits from dataBits on only represent padding
+	dataBits := (packetLength - padding) * 8
+	for i := range chars {
+		for bit := 0; bit < 5; bit++ {
+			if 5*i+bit >= dataBits {
+				chars[i].PaddingMask |= 0x10 >> uint(bit)
+			}
+		}
+	}
+
+	byteReports := make([]ByteReport, len(chars)*5/8)
+	for i := range byteReports {
+		byteReports[i] = ByteReport{
+			Value:     byte(word >> uint(32-8*i)),
+			FirstChar: i * 8 / 5,
+			LastChar:  (i*8 + 7) / 5,
+			Padding:   i >= packetLength-padding,
+		}
+	}
+
+	return PacketReport{Chars: chars, Bytes: byteReports}
+}
@@ -0,0 +1,112 @@
+package bfh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Explain(t *testing.T) {
+	t.Run("normal", func(t *testing.T) {
+		report, err := Explain("4-zwga-e07x-2400-0000")
+		require.NoError(t, err)
+
+		assert.False(t, report.Strict)
+		require.NotNil(t, report.Header)
+		assert.Equal(t, CharReport{Offset: 0, Rune: '4', Value: 4, Valid: true}, *report.Header)
+		assert.Equal(t, 4, report.Padding)
+		assert.Equal(t, []byte{255, 32, 167, 0, 253, 17}, report.Data)
+		require.Len(t, report.Packets, 2)
+
+		first := report.Packets[0]
+		assert.Equal(t, CharReport{Offset: 2, Rune: 'z', Value: 31, Valid: true, Group: 0}, first.Chars[0])
+		assert.Equal(t, CharReport{Offset: 7, Rune: 'e', Value: 14, Valid: true, Group: 1}, first.Chars[4])
+		assert.Equal(t, "11111", first.Chars[0].Bits())
+		assert.Equal(t, ByteReport{Value: 255, FirstChar: 0, LastChar: 1}, first.Bytes[0])
+		assert.Equal(t, ByteReport{Value: 32, FirstChar: 1, LastChar: 3}, first.Bytes[1])
+		assert.Equal(t, ByteReport{Value: 253, FirstChar: 6, LastChar: 7}, first.Bytes[4])
+
+		last := report.Packets[1]
+		assert.Equal(t, []ByteReport{
+			{Value: 17, FirstChar: 0, LastChar: 1},
+			{Value: 0, FirstChar: 1, LastChar: 3, Padding: true},
+			{Value: 0, FirstChar: 3, LastChar: 4, Padding: true},
+			{Value: 0, FirstChar: 4, LastChar: 6, Padding: true},
+			{Value: 0, FirstChar: 6, LastChar: 7, Padding: true},
+		}, last.Bytes)
+		assert.Equal(t, byte(0x00), last.Chars[0].PaddingMask)
+		assert.Equal(t, byte(0x03), last.Chars[1].PaddingMask)
+		assert.Equal(t, byte(0x1f), last.Chars[2].PaddingMask)
+		assert.Equal(t, byte(0x1f), last.Chars[7].PaddingMask)
+		assert.Equal(t, 2, last.Chars[0].Group)
+		assert.Equal(t, 3, last.Chars[7].Group)
+	})
+
+	t.Run("strict", func(t *testing.T) {
+		report, err := Explain("zwga-e07x")
+		require.NoError(t, err)
+
+		assert.True(t, report.Strict)
+		assert.Nil(t, report.Header)
+		assert.Equal(t, []byte{255, 32, 167, 0, 253}, report.Data)
+		require.Len(t, report.Packets, 1)
+		assert.Len(t, report.Packets[0].Bytes, 5)
+	})
+
+	t.Run("non-canonical", func(t *testing.T) {
+		report, err := Explain("4-zwga-e07x-2400-000z")
+		require.NoError(t, err)
+
+		last := report.Packets[1].Chars[7]
+		assert.True(t, last.NonCanonical())
+		assert.False(t, report.Packets[1].Chars[0].NonCanonical())
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name            string
+			String          string
+			ExpectedErr     error
+			ExpectedPackets int
+		}{
+			{
+				Name:            "invalid character",
+				String:          "4-zwga-e07u-2400-0000",
+				ExpectedErr:     ErrInvalidCharacter,
+				ExpectedPackets: 2,
+			},
+			{
+				Name:            "invalid length",
+				String:          "4-zwga-e07x-24",
+				ExpectedErr:     ErrInvalidLength,
+				ExpectedPackets: 2,
+			},
+			{
+				Name:            "invalid padding",
+				String:          "9-zwga-e07x",
+				ExpectedErr:     ErrInvalidPadding,
+				ExpectedPackets: 1,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				report, err := Explain(tt.String)
+
+				assert.ErrorIs(t, err, tt.ExpectedErr, fmt.Sprintf("Failing value: %s", tt.String))
+				require.NotNil(t, report)
+				assert.Equal(t, err, report.Err)
+				assert.Nil(t, report.Data)
+				assert.Len(t, report.Packets, tt.ExpectedPackets)
+			})
+		}
+	})
+
+	t.Run("invalid character is reported", func(t *testing.T) {
+		report, _ := Explain("4-zwga-e07u-2400-0000")
+
+		assert.Equal(t, CharReport{Offset: 10, Rune: 'u', Valid: false, Group: 1}, report.Packets[0].Chars[7])
+	})
+}
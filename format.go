@@ -0,0 +1,189 @@
+package bfh
+
+import (
+	"errors"
+)
+
+const (
+	// CurrentVersion is the version of the self-describing header written by EncodeTagged
+	CurrentVersion = 1
+
+	// the padding digit only uses the values 0 to 4, header values from 16 to 31 describe the format instead
+	headerTagged      = 0x10
+	headerStrict      = 0x08
+	headerChecked     = 0x04
+	headerVersionMask = 0x03
+)
+
+// Format describes how a string was encoded
+// The zero value describes normal mode without a check symbol, written by EncodeTagged with the current version.
+type Format struct {
+	// Version is the version of the self-describing header, 0 if the string has none
+	Version int
+	// Strict is true if the data was encoded in strict mode
+	Strict bool
+	// Checked is true if the string ends in a check symbol
+	Checked bool
+}
+
+// EncodeTagged encodes binary data into a human readable string starting with a self-describing header
+// Only CurrentVersion can be written, the zero Version selects it.
+func EncodeTagged(b []byte, f Format) (string, error) {
+	return StdEncoding.EncodeTagged(b, f)
+}
+
+// DecodeAuto decodes a human readable string, detecting the format it was encoded in
+func DecodeAuto(str string) ([]byte, Format, error) {
+	return StdEncoding.DecodeAuto(str)
+}
+
+// EncodeTagged encodes binary data into a human readable string starting with a self-describing header
+// The header digit replaces the padding digit of normal mode with a value from 16 to 31: the 16 bit marks the header,
+// the 8 bit strict mode, the 4 bit the check symbol and the lowest 2 bits the version. In normal mode the padding digit
+// follows the header digit, e.g. h4-zwga-e07x-2400-0000, while in strict mode the data does, e.g. s-zwga-e07x.
+func (enc *Encoding) EncodeTagged(b []byte, f Format) (string, error) {
+	if b == nil {
+		return "", ErrNilInput
+	}
+
+	if f.Version == 0 {
+		f.Version = CurrentVersion
+	}
+
+	// other versions may describe different layouts, which are not known yet
+	if f.Version != CurrentVersion {
+		return "", ErrUnknownFormat
+	}
+
+	if f.Checked && enc.checkSymbols == "" {
+		return "", errors.New(errMsgCheckSymbolsUnavailable)
+	}
+
+	header := byte(headerTagged | f.Version)
+	if f.Strict {
+		header |= headerStrict
+	}
+	if f.Checked {
+		header |= headerChecked
+	}
+
+	prefix := []byte{enc.alphabet[header]}
+	dataDigits := (len(b) + 4) / 5 * 8
+
+	if f.Strict {
+		if len(b)%5 != 0 {
+			return "", strictLengthError()
+		}
+	} else {
+		prefix = append(prefix, enc.alphabet[(5-len(b)%5)%5])
+	}
+
+	if enc.groupLength > 0 {
+		prefix = append(prefix, enc.separator)
+	}
+
+	// the check symbol and its separator may be appended later
+	result := make([]byte, len(prefix)+enc.groupedLength(dataDigits), len(prefix)+enc.groupedLength(dataDigits)+2)
+	copy(result, prefix)
+	enc.encode(b, result, len(prefix))
+
+	if f.Checked {
+		result = enc.appendCheckSymbol(result, b)
+	}
+
+	return string(result), nil
+}
+
+// DecodeAuto decodes a human readable string, detecting the format it was encoded in
+// Strings starting with a self-describing header are decoded as described by it. Strings without one are decoded in
+// strict mode if the number of digits is some multiple of 8, in normal mode otherwise, as the padding digit makes the
+// difference, including the empty string. The check symbol of strings without a header can not be detected, while
+// headers of any version but CurrentVersion are rejected with ErrUnknownFormat.
+func (enc *Encoding) DecodeAuto(str string) ([]byte, Format, error) {
+	digitCount := len(str) - countByte(str, enc.separator)
+
+	// strings without any digits are empty data encoded in strict mode
+	if digitCount%8 == 0 {
+		data, err := enc.DecodeStrictStr(str)
+		if err != nil {
+			return nil, Format{}, err
+		}
+
+		return data, Format{Strict: true}, nil
+	}
+
+	first := enc.skipSeparators(str, 0)
+
+	header, err := enc.getDigit(str[first])
+	if err != nil {
+		return nil, Format{}, newCorruptInputError(str, first, err)
+	}
+
+	if header&headerTagged == 0 {
+		data, err := enc.decodeNormal(str)
+		if err != nil {
+			return nil, Format{}, err
+		}
+
+		return data, Format{}, nil
+	}
+
+	f := Format{
+		Version: int(header & headerVersionMask),
+		Strict:  header&headerStrict != 0,
+		Checked: header&headerChecked != 0,
+	}
+
+	if f.Version != CurrentVersion {
+		return nil, Format{}, newCorruptInputError(str, first, ErrUnknownFormat)
+	}
+
+	// the check symbol does not cover the padding bits, therefore checked strings are always decoded canonically
+	dec := enc
+	if f.Checked {
+		dec = enc.canonicalEncoding()
+	}
+
+	decode := dec.decodeNormal
+	if f.Strict {
+		decode = dec.DecodeStrictStr
+	}
+
+	rest := str[first+1:]
+
+	var data []byte
+	if f.Checked {
+		data, err = enc.decodeChecked(rest, decode)
+	} else {
+		data, err = decode(rest)
+	}
+
+	if err != nil {
+		return nil, Format{}, shiftOffset(err, first+1)
+	}
+
+	return data, f, nil
+}
+
+// decodeNormal decodes a string starting with a padding digit, even if the encoding does not use the padding header
+func (enc *Encoding) decodeNormal(str string) ([]byte, error) {
+	data, err := enc.appendDecodeNormal(make([]byte, 0, enc.DecodedLen(len(str))), str)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// shiftOffset moves the offset of a CorruptInputError found in a substring starting at n to the original string
+func shiftOffset(err error, n int) error {
+	var corruptErr *CorruptInputError
+	if !errors.As(err, &corruptErr) {
+		return err
+	}
+
+	shifted := *corruptErr
+	shifted.Offset += n
+
+	return &shifted
+}
@@ -0,0 +1,276 @@
+package bfh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EncodeTagged(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Bytes          []byte
+			Format         Format
+			ExpectedResult string
+		}{
+			{
+				Name:           "normal",
+				Bytes:          []byte{255, 32, 167, 0, 253, 17},
+				Format:         Format{},
+				ExpectedResult: "h4-zwga-e07x-2400-0000",
+			},
+			{
+				Name:           "normal empty",
+				Bytes:          []byte{},
+				Format:         Format{},
+				ExpectedResult: "h0-",
+			},
+			{
+				Name:           "strict",
+				Bytes:          []byte{255, 32, 167, 0, 253},
+				Format:         Format{Strict: true},
+				ExpectedResult: "s-zwga-e07x",
+			},
+			{
+				Name:           "checked",
+				Bytes:          []byte{255, 32, 167, 0, 253, 17},
+				Format:         Format{Checked: true},
+				ExpectedResult: "n4-zwga-e07x-2400-0000-f",
+			},
+			{
+				Name:           "strict checked",
+				Bytes:          []byte{255, 32, 167, 0, 253},
+				Format:         Format{Strict: true, Checked: true},
+				ExpectedResult: "x-zwga-e07x-d",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := EncodeTagged(tt.Bytes, tt.Format)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name        string
+			Bytes       []byte
+			Format      Format
+			ExpectedErr error
+		}{
+			{
+				Name:        "nil",
+				Bytes:       nil,
+				Format:      Format{},
+				ExpectedErr: ErrNilInput,
+			},
+			{
+				Name:        "strict wrong length",
+				Bytes:       []byte{1, 2, 3},
+				Format:      Format{Strict: true},
+				ExpectedErr: ErrInvalidLength,
+			},
+			{
+				Name:        "version 2",
+				Bytes:       []byte{1, 2, 3},
+				Format:      Format{Version: 2},
+				ExpectedErr: ErrUnknownFormat,
+			},
+			{
+				Name:        "version 3",
+				Bytes:       []byte{1, 2, 3},
+				Format:      Format{Version: 3},
+				ExpectedErr: ErrUnknownFormat,
+			},
+			{
+				Name:        "unknown version",
+				Bytes:       []byte{1, 2, 3},
+				Format:      Format{Version: 4},
+				ExpectedErr: ErrUnknownFormat,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := EncodeTagged(tt.Bytes, tt.Format)
+
+				assert.ErrorIs(t, err, tt.ExpectedErr)
+			})
+		}
+	})
+}
+
+func Test_DecodeAuto(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedResult []byte
+			ExpectedFormat Format
+		}{
+			{
+				Name:           "legacy normal",
+				String:         "4-zwga-e07x-2400-0000",
+				ExpectedResult: []byte{255, 32, 167, 0, 253, 17},
+				ExpectedFormat: Format{},
+			},
+			{
+				Name:           "legacy normal empty",
+				String:         "0-",
+				ExpectedResult: []byte{},
+				ExpectedFormat: Format{},
+			},
+			{
+				Name:           "legacy strict empty",
+				String:         "",
+				ExpectedResult: []byte{},
+				ExpectedFormat: Format{Strict: true},
+			},
+			{
+				Name:           "legacy strict",
+				String:         "zwga-e07x",
+				ExpectedResult: []byte{255, 32, 167, 0, 253},
+				ExpectedFormat: Format{Strict: true},
+			},
+			{
+				Name:           "legacy strict starting with a small digit",
+				String:         "0000-0000",
+				ExpectedResult: []byte{0, 0, 0, 0, 0},
+				ExpectedFormat: Format{Strict: true},
+			},
+			{
+				Name:           "tagged normal",
+				String:         "h4-zwga-e07x-2400-0000",
+				ExpectedResult: []byte{255, 32, 167, 0, 253, 17},
+				ExpectedFormat: Format{Version: 1},
+			},
+			{
+				Name:           "tagged strict",
+				String:         "s-zwga-e07x",
+				ExpectedResult: []byte{255, 32, 167, 0, 253},
+				ExpectedFormat: Format{Version: 1, Strict: true},
+			},
+			{
+				Name:           "tagged checked",
+				String:         "n4-zwga-e07x-2400-0000-f",
+				ExpectedResult: []byte{255, 32, 167, 0, 253, 17},
+				ExpectedFormat: Format{Version: 1, Checked: true},
+			},
+			{
+				Name:           "tagged strict checked",
+				String:         "x-zwga-e07x-d",
+				ExpectedResult: []byte{255, 32, 167, 0, 253},
+				ExpectedFormat: Format{Version: 1, Strict: true, Checked: true},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, actualFormat, err := DecodeAuto(tt.String)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+				assert.Equal(t, tt.ExpectedFormat, actualFormat)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedErr    error
+			ExpectedOffset int
+		}{
+			{
+				Name:           "version 0",
+				String:         "g4-zwga-e07x-2400-0000",
+				ExpectedErr:    ErrUnknownFormat,
+				ExpectedOffset: 0,
+			},
+			{
+				Name:           "version 2",
+				String:         "j4-zwga-e07x-2400-0000",
+				ExpectedErr:    ErrUnknownFormat,
+				ExpectedOffset: 0,
+			},
+			{
+				Name:           "version 3",
+				String:         "k4-zwga-e07x-2400-0000",
+				ExpectedErr:    ErrUnknownFormat,
+				ExpectedOffset: 0,
+			},
+			{
+				Name:           "version 3 after separator",
+				String:         "-k4-zwga-e07x-2400-0000",
+				ExpectedErr:    ErrUnknownFormat,
+				ExpectedOffset: 1,
+			},
+			{
+				Name:           "invalid character after header",
+				String:         "h4-zwga-e07x-24u0-0000",
+				ExpectedErr:    ErrInvalidCharacter,
+				ExpectedOffset: 15,
+			},
+			{
+				Name:           "neither header nor padding",
+				String:         "a-zwga-e07x",
+				ExpectedErr:    ErrInvalidPadding,
+				ExpectedOffset: 0,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, _, err := DecodeAuto(tt.String)
+
+				require.ErrorIs(t, err, tt.ExpectedErr, fmt.Sprintf("Failing value: %s", tt.String))
+
+				var corruptErr *CorruptInputError
+				require.ErrorAs(t, err, &corruptErr)
+				assert.Equal(t, tt.ExpectedOffset, corruptErr.Offset)
+			})
+		}
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		_, _, err := DecodeAuto("n4-zwga-e0x7-2400-0000-f")
+
+		assert.ErrorIs(t, err, ErrChecksumMismatch)
+	})
+
+	t.Run("checked with non-zero padding bits", func(t *testing.T) {
+		_, _, err := DecodeAuto("n4-04zz-zzzz-1")
+
+		assert.ErrorIs(t, err, ErrNonCanonical)
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		formats := []Format{{}, {Strict: true}, {Checked: true}, {Strict: true, Checked: true}}
+
+		for _, f := range formats {
+			for length := 0; length <= 20; length += 5 {
+				b := make([]byte, length)
+				for i := range b {
+					b[i] = byte(i*37 + length)
+				}
+
+				encoded, err := EncodeTagged(b, f)
+				require.NoError(t, err)
+
+				decoded, actualFormat, err := DecodeAuto(encoded)
+				require.NoError(t, err, fmt.Sprintf("Failing value: %s", encoded))
+
+				assert.Equal(t, b, decoded)
+				assert.Equal(t, Format{Version: CurrentVersion, Strict: f.Strict, Checked: f.Checked}, actualFormat)
+			}
+		}
+	})
+}
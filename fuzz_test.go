@@ -0,0 +1,256 @@
+//go:build go1.18
+// +build go1.18
+
+package bfh
+
+import (
+	"bytes"
+	"encoding/base32"
+	"strings"
+	"testing"
+)
+
+// stringSeeds are taken from the table tests of bfh_test.go
+var stringSeeds = []string{
+	"",
+	"-",
+	"--",
+	"0",
+	"0-",
+	"0-0000-0000",
+	"0-zwga-e0",
+	"0-zzzz-zzzz",
+	"0000-0000",
+	"1-zwga-e07x-2400-000z",
+	"1-zwga-e07x-2400-00z0",
+	"1-zzzz-zzr0",
+	"2-zwga-e07x-2400-000z",
+	"2-zwga-e07x-2400-z000",
+	"3-zwga-e07x-2400-000z",
+	"3-zwga-e07x-240z-0000",
+	"4-fr00-0000",
+	"4-ouga-e07x-2400-0000",
+	"4-owg0",
+	"4-zw00-0000",
+	"4-zwg",
+	"4-zwg-ae0-7x2-400-00-00",
+	"4-zwga-e07x-2400-0000",
+	"4-zwga-e07x-2400-0000-",
+	"4-zwga-e07x-2400-000z",
+	"4-zwga-e07x-2z00-0000",
+	"4-zzzz-zzzz-zw00-0000",
+	"6-zwga-e07x-2400-0000",
+	"7-zwga-e07x-2400-0000",
+	"a-",
+	"fr00-0000",
+	"o-zwga-e07x-2400-0000",
+	"ouga-e07x-2400-0000",
+	"uuuu-zzzz",
+	"zw00-0000",
+	"zwg",
+	"zwg-ae0-7x2-400-00-00",
+	"zwga-e0",
+	"zwga-e07x-2400-0000",
+	"zwga-e07x-2400-0000-",
+	"zzzz-zzr0",
+	"zzzz-zzzz",
+	"zzzz-zzzz-zw00-0000",
+	"zzzz0zzzz",
+}
+
+// byteSeeds are taken from the table tests of bfh_test.go
+var byteSeeds = [][]byte{
+	{},
+	{126},
+	{255},
+	{255, 0, 0, 0, 0},
+	{0, 0, 0, 0, 0},
+	{255, 255, 255, 255, 255},
+	{255, 255, 255, 255, 255, 255},
+	{255, 255, 255, 255},
+	{255, 32, 167, 0, 253, 17},
+}
+
+// base32Encoding is the standard library's base32 using the same alphabet as bfh
+var base32Encoding = base32.NewEncoding(digits).WithPadding(base32.NoPadding)
+
+func FuzzDecodeStr(f *testing.F) {
+	for _, seed := range stringSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, str string) {
+		data, err := DecodeStr(str)
+		if err != nil {
+			return
+		}
+
+		encoded, err := EncodeStr(data)
+		if err != nil {
+			t.Fatalf("decoded data of %q can not be encoded: %v", str, err)
+		}
+
+		redecoded, err := DecodeStr(encoded)
+		if err != nil || !bytes.Equal(data, redecoded) {
+			t.Fatalf("%q decoded to %v, but its encoding %q decoded to %v, %v", str, data, encoded, redecoded, err)
+		}
+	})
+}
+
+func FuzzDecodeStrictStr(f *testing.F) {
+	for _, seed := range stringSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, str string) {
+		data, err := DecodeStrictStr(str)
+		if err != nil {
+			return
+		}
+
+		if len(data)%5 != 0 {
+			t.Fatalf("%q decoded to %d bytes in strict mode", str, len(data))
+		}
+
+		expected, err := base32Encoding.DecodeString(RemoveByte(str, separator))
+		if err != nil || !bytes.Equal(expected, data) {
+			t.Fatalf("%q decoded to %v, base32 decoded it to %v, %v", str, data, expected, err)
+		}
+	})
+}
+
+func FuzzIsAcceptable(f *testing.F) {
+	for _, seed := range stringSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, str string) {
+		acceptable := IsAcceptable(str)
+		wellFormatted := IsWellFormatted(str)
+		strict := IsStrict(str)
+
+		if acceptable != (Validate(str) == nil) {
+			t.Fatalf("IsAcceptable and Validate disagree on %q", str)
+		}
+
+		if wellFormatted != (ValidateWellFormatted(str) == nil) {
+			t.Fatalf("IsWellFormatted and ValidateWellFormatted disagree on %q", str)
+		}
+
+		if strict != (ValidateStrict(str) == nil) {
+			t.Fatalf("IsStrict and ValidateStrict disagree on %q", str)
+		}
+
+		if wellFormatted && !acceptable {
+			t.Fatalf("%q is well-formatted but not acceptable", str)
+		}
+
+		if _, err := DecodeStr(str); acceptable && err != nil {
+			t.Fatalf("%q is acceptable but can not be decoded: %v", str, err)
+		}
+
+		if _, err := DecodeStrictStr(str); strict && err != nil {
+			t.Fatalf("%q is strict but can not be decoded in strict mode: %v", str, err)
+		}
+
+		// the rest of the exported entry points taking strings must not panic either
+		_, _ = DecodeCheckedStr(str)
+		_, _ = DecodeStrictCheckedStr(str)
+		_ = IsAcceptableChecked(str)
+		_ = IsWellFormattedChecked(str)
+		_ = IsStrictChecked(str)
+		_, _, _ = DecodeLenientStr(str)
+		_, _, _ = DecodeStrictLenientStr(str)
+		_, _, _ = DecodeAuto(str)
+
+		if _, err := DecodeRawStr(str); IsRaw(str) && err != nil {
+			t.Fatalf("%q is raw but can not be decoded in raw mode: %v", str, err)
+		}
+	})
+}
+
+func FuzzRoundTrip(f *testing.F) {
+	for _, seed := range byteSeeds {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		encoded, err := EncodeStr(data)
+		if err != nil {
+			t.Fatalf("%v can not be encoded: %v", data, err)
+		}
+
+		if !IsWellFormatted(encoded) {
+			t.Fatalf("%v encoded to %q, which is not well-formatted", data, encoded)
+		}
+
+		decoded, err := DecodeStr(encoded)
+		if err != nil || !bytes.Equal(data, decoded) {
+			t.Fatalf("%v encoded to %q, which decoded to %v, %v", data, encoded, decoded, err)
+		}
+
+		// the data digits are the base32 encoding of the data padded with zeros to a multiple of 5 bytes
+		padded := make([]byte, (len(data)+4)/5*5)
+		copy(padded, data)
+
+		expected := base32Encoding.EncodeToString(padded)
+		if actual := RemoveByte(encoded[2:], separator); actual != expected {
+			t.Fatalf("%v encoded to %q, base32 encoded it to %q", data, actual, expected)
+		}
+
+		// raw mode is the same as base32 without padding
+		encoded, err = EncodeRawStr(data)
+		if err != nil {
+			t.Fatalf("%v can not be encoded in raw mode: %v", data, err)
+		}
+
+		if actual := RemoveByte(encoded, separator); actual != base32Encoding.EncodeToString(data) {
+			t.Fatalf("%v encoded to %q in raw mode, base32 encoded it to %q", data, actual, base32Encoding.EncodeToString(data))
+		}
+
+		decoded, err = DecodeRawStr(encoded)
+		if err != nil || !bytes.Equal(data, decoded) {
+			t.Fatalf("%v encoded to %q in raw mode, which decoded to %v, %v", data, encoded, decoded, err)
+		}
+
+		if len(data)%5 != 0 {
+			return
+		}
+
+		encoded, err = EncodeStrictStr(data)
+		if err != nil {
+			t.Fatalf("%v can not be encoded in strict mode: %v", data, err)
+		}
+
+		if actual := RemoveByte(encoded, separator); actual != expected {
+			t.Fatalf("%v encoded to %q in strict mode, base32 encoded it to %q", data, actual, expected)
+		}
+	})
+}
+
+func FuzzOrdered(f *testing.F) {
+	for i, seed := range byteSeeds {
+		f.Add(seed, byteSeeds[(i+1)%len(byteSeeds)])
+	}
+
+	f.Fuzz(func(t *testing.T, a, b []byte) {
+		encodedA, err := EncodeOrderedStr(a)
+		if err != nil {
+			t.Fatalf("%v can not be encoded: %v", a, err)
+		}
+
+		encodedB, err := EncodeOrderedStr(b)
+		if err != nil {
+			t.Fatalf("%v can not be encoded: %v", b, err)
+		}
+
+		expected := bytes.Compare(a, b)
+		if actual := strings.Compare(encodedA, encodedB); actual != expected {
+			t.Fatalf("%v and %v compare as %d, but %q and %q as %d", a, b, expected, encodedA, encodedB, actual)
+		}
+
+		if actual := Compare(encodedA, encodedB); actual != expected {
+			t.Fatalf("%v and %v compare as %d, but Compare(%q, %q) returned %d", a, b, expected, encodedA, encodedB, actual)
+		}
+	})
+}
@@ -0,0 +1,218 @@
+package bfh
+
+import (
+	"bytes"
+	"encoding"
+)
+
+const (
+	// ID80Length is the number of bytes in an ID80
+	ID80Length = 10
+	// ID120Length is the number of bytes in an ID120
+	ID120Length = 15
+	// ID160Length is the number of bytes in an ID160
+	ID160Length = 20
+)
+
+// the lengths of the IDs must be some multiple of 5 to be encoded in strict mode, this fails to compile otherwise
+var (
+	_ [0]struct{} = [ID80Length % 5]struct{}{}
+	_ [0]struct{} = [ID120Length % 5]struct{}{}
+	_ [0]struct{} = [ID160Length % 5]struct{}{}
+)
+
+var (
+	_ encoding.TextMarshaler   = ID80{}
+	_ encoding.TextUnmarshaler = (*ID80)(nil)
+	_ encoding.TextMarshaler   = ID120{}
+	_ encoding.TextUnmarshaler = (*ID120)(nil)
+	_ encoding.TextMarshaler   = ID160{}
+	_ encoding.TextUnmarshaler = (*ID160)(nil)
+)
+
+// ID80 is a 80-bit identifier represented by its strict mode encoded string, e.g. "xxxx-xxxx-xxxx-xxxx"
+// Being an array, it is comparable and can be used as a map key. It is marshalled as text, which JSON also uses.
+type ID80 [ID80Length]byte
+
+// ParseID80 decodes an ID80 from a strict mode encoded string
+func ParseID80(str string) (ID80, error) {
+	var id ID80
+	if err := parseID(id[:], str); err != nil {
+		return ID80{}, err
+	}
+
+	return id, nil
+}
+
+// MustParseID80 decodes an ID80 from a strict mode encoded string, panicking on failure
+func MustParseID80(str string) ID80 {
+	id, err := ParseID80(str)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+// String returns the strict mode encoded string
+func (id ID80) String() string {
+	return formatID(id[:])
+}
+
+// Compare returns -1, 0 or 1 if id sorts before, the same as or after other
+func (id ID80) Compare(other ID80) int {
+	return bytes.Compare(id[:], other[:])
+}
+
+// IsZero returns true if all bytes of id are zeros
+func (id ID80) IsZero() bool {
+	return id == ID80{}
+}
+
+// MarshalText returns the strict mode encoded string
+func (id ID80) MarshalText() ([]byte, error) {
+	return EncodeStrict(id[:])
+}
+
+// UnmarshalText decodes a strict mode encoded string
+func (id *ID80) UnmarshalText(text []byte) error {
+	parsed, err := ParseID80(string(text))
+	if err != nil {
+		return err
+	}
+
+	*id = parsed
+
+	return nil
+}
+
+// ID120 is a 120-bit identifier represented by its strict mode encoded string, e.g. "xxxx-xxxx-xxxx-xxxx-xxxx-xxxx"
+// Being an array, it is comparable and can be used as a map key. It is marshalled as text, which JSON also uses.
+type ID120 [ID120Length]byte
+
+// ParseID120 decodes an ID120 from a strict mode encoded string
+func ParseID120(str string) (ID120, error) {
+	var id ID120
+	if err := parseID(id[:], str); err != nil {
+		return ID120{}, err
+	}
+
+	return id, nil
+}
+
+// MustParseID120 decodes an ID120 from a strict mode encoded string, panicking on failure
+func MustParseID120(str string) ID120 {
+	id, err := ParseID120(str)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+// String returns the strict mode encoded string
+func (id ID120) String() string {
+	return formatID(id[:])
+}
+
+// Compare returns -1, 0 or 1 if id sorts before, the same as or after other
+func (id ID120) Compare(other ID120) int {
+	return bytes.Compare(id[:], other[:])
+}
+
+// IsZero returns true if all bytes of id are zeros
+func (id ID120) IsZero() bool {
+	return id == ID120{}
+}
+
+// MarshalText returns the strict mode encoded string
+func (id ID120) MarshalText() ([]byte, error) {
+	return EncodeStrict(id[:])
+}
+
+// UnmarshalText decodes a strict mode encoded string
+func (id *ID120) UnmarshalText(text []byte) error {
+	parsed, err := ParseID120(string(text))
+	if err != nil {
+		return err
+	}
+
+	*id = parsed
+
+	return nil
+}
+
+// ID160 is a 160-bit identifier represented by its strict mode encoded string, e.g. "xxxx-xxxx-xxxx-xxxx-xxxx-xxxx-xxxx-xxxx"
+// Being an array, it is comparable and can be used as a map key. It is marshalled as text, which JSON also uses.
+type ID160 [ID160Length]byte
+
+// ParseID160 decodes an ID160 from a strict mode encoded string
+func ParseID160(str string) (ID160, error) {
+	var id ID160
+	if err := parseID(id[:], str); err != nil {
+		return ID160{}, err
+	}
+
+	return id, nil
+}
+
+// MustParseID160 decodes an ID160 from a strict mode encoded string, panicking on failure
+func MustParseID160(str string) ID160 {
+	id, err := ParseID160(str)
+	if err != nil {
+		panic(err)
+	}
+
+	return id
+}
+
+// String returns the strict mode encoded string
+func (id ID160) String() string {
+	return formatID(id[:])
+}
+
+// Compare returns -1, 0 or 1 if id sorts before, the same as or after other
+func (id ID160) Compare(other ID160) int {
+	return bytes.Compare(id[:], other[:])
+}
+
+// IsZero returns true if all bytes of id are zeros
+func (id ID160) IsZero() bool {
+	return id == ID160{}
+}
+
+// MarshalText returns the strict mode encoded string
+func (id ID160) MarshalText() ([]byte, error) {
+	return EncodeStrict(id[:])
+}
+
+// UnmarshalText decodes a strict mode encoded string
+func (id *ID160) UnmarshalText(text []byte) error {
+	parsed, err := ParseID160(string(text))
+	if err != nil {
+		return err
+	}
+
+	*id = parsed
+
+	return nil
+}
+
+// parseID decodes a strict mode encoded string into id, rejecting strings of any other length up front
+func parseID(id []byte, str string) error {
+	if len(str)-countByte(str, StdEncoding.separator) != len(id)/5*8 {
+		return newCorruptInputError(str, len(str), ErrInvalidLength)
+	}
+
+	_, err := StdEncoding.appendDecodeStrict(id[:0], str)
+
+	return err
+}
+
+// formatID returns the strict mode encoded string of id
+func formatID(id []byte) string {
+	result := make([]byte, StdEncoding.EncodedStrictLen(len(id)))
+	StdEncoding.encodeStrict(result, id)
+
+	return bytesToString(result)
+}
@@ -0,0 +1,183 @@
+package bfh
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ParseID80(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedResult ID80
+		}{
+			{
+				Name:           "grouped",
+				String:         "zwga-e07x-2410-6105",
+				ExpectedResult: ID80{255, 32, 167, 0, 253, 17, 2, 3, 4, 5},
+			},
+			{
+				Name:           "ungrouped",
+				String:         "zwgae07x24106105",
+				ExpectedResult: ID80{255, 32, 167, 0, 253, 17, 2, 3, 4, 5},
+			},
+			{
+				Name:           "zero",
+				String:         "0000-0000-0000-0000",
+				ExpectedResult: ID80{},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := ParseID80(tt.String)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name          string
+			String        string
+			ExpectedError error
+		}{
+			{
+				Name:          "empty",
+				String:        "",
+				ExpectedError: ErrInvalidLength,
+			},
+			{
+				Name:          "too short",
+				String:        "zwga-e07x",
+				ExpectedError: ErrInvalidLength,
+			},
+			{
+				Name:          "too long",
+				String:        "zwga-e07x-2410-6105-0000-0000",
+				ExpectedError: ErrInvalidLength,
+			},
+			{
+				Name:          "normal mode",
+				String:        "0-zwga-e07x-2410-6105",
+				ExpectedError: ErrInvalidLength,
+			},
+			{
+				Name:          "invalid character",
+				String:        "zwga-e07u-2410-6105",
+				ExpectedError: ErrInvalidCharacter,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := ParseID80(tt.String)
+
+				assert.ErrorIs(t, err, tt.ExpectedError, fmt.Sprintf("Failing value: %s", tt.String))
+			})
+		}
+	})
+}
+
+func Test_MustParseID80(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.Equal(t, ID80{255, 32, 167, 0, 253, 17, 2, 3, 4, 5}, MustParseID80("zwga-e07x-2410-6105"))
+	})
+
+	t.Run("panic", func(t *testing.T) {
+		assert.Panics(t, func() {
+			MustParseID80("zwga-e07x")
+		})
+	})
+}
+
+func Test_ID_String(t *testing.T) {
+	tests := []struct {
+		Name           string
+		ID             fmt.Stringer
+		ExpectedResult string
+	}{
+		{
+			Name:           "80 bits",
+			ID:             ID80{255, 32, 167, 0, 253, 17, 2, 3, 4, 5},
+			ExpectedResult: "zwga-e07x-2410-6105",
+		},
+		{
+			Name:           "120 bits",
+			ID:             ID120{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15},
+			ExpectedResult: "0410-6105-0r3g-g28a-1c60-t3gf",
+		},
+		{
+			Name:           "160 bits",
+			ID:             ID160{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20},
+			ExpectedResult: "0410-6105-0r3g-g28a-1c60-t3gf-208h-44rm",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.ExpectedResult, tt.ID.String())
+		})
+	}
+}
+
+func Test_ParseID120(t *testing.T) {
+	actualResult, err := ParseID120("0410-6105-0r3g-g28a-1c60-t3gf")
+	require.NoError(t, err)
+	assert.Equal(t, ID120{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15}, actualResult)
+
+	_, err = ParseID120("0410-6105-0r3g-g28a")
+	assert.ErrorIs(t, err, ErrInvalidLength)
+}
+
+func Test_ParseID160(t *testing.T) {
+	actualResult, err := ParseID160("0410-6105-0r3g-g28a-1c60-t3gf-208h-44rm")
+	require.NoError(t, err)
+	assert.Equal(t, ID160{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20}, actualResult)
+
+	_, err = ParseID160("0410-6105-0r3g-g28a-1c60-t3gf")
+	assert.ErrorIs(t, err, ErrInvalidLength)
+}
+
+func Test_ID80_Compare(t *testing.T) {
+	low := ID80{1}
+	high := ID80{2}
+
+	assert.Equal(t, -1, low.Compare(high))
+	assert.Equal(t, 0, low.Compare(low))
+	assert.Equal(t, 1, high.Compare(low))
+}
+
+func Test_ID80_IsZero(t *testing.T) {
+	assert.True(t, ID80{}.IsZero())
+	assert.False(t, ID80{9: 1}.IsZero())
+}
+
+func Test_ID80_JSON(t *testing.T) {
+	type payload struct {
+		ID     ID80            `json:"id"`
+		Counts map[ID80]string `json:"counts"`
+	}
+
+	id := ID80{255, 32, 167, 0, 253, 17, 2, 3, 4, 5}
+	expected := payload{ID: id, Counts: map[ID80]string{id: "one"}}
+
+	data, err := json.Marshal(expected)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":"zwga-e07x-2410-6105","counts":{"zwga-e07x-2410-6105":"one"}}`, string(data))
+
+	var actual payload
+	err = json.Unmarshal(data, &actual)
+	require.NoError(t, err)
+	assert.Equal(t, expected, actual)
+
+	err = json.Unmarshal([]byte(`{"id":"zwga-e07x"}`), &actual)
+	assert.ErrorIs(t, err, ErrInvalidLength)
+}
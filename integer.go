@@ -0,0 +1,207 @@
+package bfh
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+const (
+	errMsgNegativeNumber   = "number must not be negative"
+	errMsgWidthNotPositive = "width must be positive"
+
+	// uint64Digits is the number of digits any uint64 fits into, as 13 digits hold 65 bits
+	uint64Digits = 13
+	// bigIntDigits are the digits used by math/big for base 32
+	bigIntDigits = "0123456789abcdefghijklmnopqrstuv"
+)
+
+// EncodeUint64 encodes a number into a human readable string of as few digits as possible, most significant first
+func EncodeUint64(v uint64) string {
+	return StdEncoding.EncodeUint64(v)
+}
+
+// EncodeUint64Width encodes a number into a human readable string of exactly width digits, using leading zeros
+// ErrOverflow is returned if the number does not fit into width digits, each of them holding 5 bits.
+func EncodeUint64Width(v uint64, width int) (string, error) {
+	return StdEncoding.EncodeUint64Width(v, width)
+}
+
+// DecodeUint64 decodes a number from a human readable string, ErrOverflow is returned if it does not fit into 64 bits
+func DecodeUint64(str string) (uint64, error) {
+	return StdEncoding.DecodeUint64(str)
+}
+
+// DecodeUint64Width decodes a number from a human readable string of exactly width digits
+func DecodeUint64Width(str string, width int) (uint64, error) {
+	return StdEncoding.DecodeUint64Width(str, width)
+}
+
+// EncodeBigInt encodes a non-negative number into a human readable string of as few digits as possible, most
+// significant first
+func EncodeBigInt(v *big.Int) (string, error) {
+	return StdEncoding.EncodeBigInt(v)
+}
+
+// DecodeBigInt decodes a number of any size from a human readable string
+func DecodeBigInt(str string) (*big.Int, error) {
+	return StdEncoding.DecodeBigInt(str)
+}
+
+// EncodeUint64 encodes a number into a human readable string of as few digits as possible, most significant first
+// Digits are grouped the same way as binary data, starting with the most significant digit.
+func (enc *Encoding) EncodeUint64(v uint64) string {
+	var buf [uint64Digits]byte
+
+	i := len(buf)
+	for {
+		i--
+		buf[i] = enc.alphabet[v&0x1f]
+		v >>= 5
+
+		if v == 0 {
+			break
+		}
+	}
+
+	return enc.groupDigits(buf[i:])
+}
+
+// EncodeUint64Width encodes a number into a human readable string of exactly width digits, using leading zeros
+func (enc *Encoding) EncodeUint64Width(v uint64, width int) (string, error) {
+	if width <= 0 {
+		return "", fmt.Errorf("%w: %s", ErrInvalidLength, errMsgWidthNotPositive)
+	}
+
+	if width < uint64Digits && v>>uint(5*width) != 0 {
+		return "", ErrOverflow
+	}
+
+	buf := make([]byte, width)
+	for i := width - 1; i >= 0; i-- {
+		buf[i] = enc.alphabet[v&0x1f]
+		v >>= 5
+	}
+
+	return enc.groupDigits(buf), nil
+}
+
+// DecodeUint64 decodes a number from a human readable string, separators are ignored wherever they are
+func (enc *Encoding) DecodeUint64(str string) (uint64, error) {
+	v, _, err := enc.decodeUint64(str)
+
+	return v, err
+}
+
+// DecodeUint64Width decodes a number from a human readable string of exactly width digits
+func (enc *Encoding) DecodeUint64Width(str string, width int) (uint64, error) {
+	v, digitCount, err := enc.decodeUint64(str)
+	if err != nil {
+		return 0, err
+	}
+
+	if digitCount != width {
+		return 0, newCorruptInputError(str, len(str), ErrInvalidLength)
+	}
+
+	return v, nil
+}
+
+// EncodeBigInt encodes a non-negative number into a human readable string of as few digits as possible, most
+// significant first
+func (enc *Encoding) EncodeBigInt(v *big.Int) (string, error) {
+	if v == nil {
+		return "", ErrNilInput
+	}
+
+	if v.Sign() < 0 {
+		return "", errors.New(errMsgNegativeNumber)
+	}
+
+	buf := []byte(v.Text(32))
+	for i, c := range buf {
+		if c <= '9' {
+			buf[i] = enc.alphabet[c-'0']
+		} else {
+			buf[i] = enc.alphabet[c-'a'+10]
+		}
+	}
+
+	return enc.groupDigits(buf), nil
+}
+
+// DecodeBigInt decodes a number of any size from a human readable string, separators are ignored wherever they are
+func (enc *Encoding) DecodeBigInt(str string) (*big.Int, error) {
+	buf := make([]byte, 0, len(str))
+
+	for i := 0; i < len(str); i++ {
+		if str[i] == enc.separator {
+			continue
+		}
+
+		value, err := enc.getDigit(str[i])
+		if err != nil {
+			return nil, newCorruptInputError(str, i, err)
+		}
+
+		buf = append(buf, bigIntDigits[value])
+	}
+
+	if len(buf) == 0 {
+		return nil, newCorruptInputError(str, len(str), ErrInvalidLength)
+	}
+
+	// buf only contains valid digits, therefore it can not fail
+	v, _ := new(big.Int).SetString(string(buf), 32)
+
+	return v, nil
+}
+
+// decodeUint64 decodes a number from str, also returning the number of digits found
+func (enc *Encoding) decodeUint64(str string) (uint64, int, error) {
+	var v uint64
+
+	digitCount := 0
+	for i := 0; i < len(str); i++ {
+		if str[i] == enc.separator {
+			continue
+		}
+
+		value, err := enc.getDigit(str[i])
+		if err != nil {
+			return 0, 0, newCorruptInputError(str, i, err)
+		}
+
+		if v > math.MaxUint64>>5 {
+			return 0, 0, newCorruptInputError(str, i, ErrOverflow)
+		}
+
+		v = v<<5 | uint64(value)
+		digitCount++
+	}
+
+	if digitCount == 0 {
+		return 0, 0, newCorruptInputError(str, len(str), ErrInvalidLength)
+	}
+
+	return v, digitCount, nil
+}
+
+// groupDigits returns chars with separators placed between the groups
+func (enc *Encoding) groupDigits(chars []byte) string {
+	if enc.groupLength == 0 {
+		return string(chars)
+	}
+
+	result := make([]byte, 0, enc.groupedLength(len(chars)))
+	for i, c := range chars {
+		if i > 0 && i%enc.groupLength == 0 {
+			result = append(result, enc.separator)
+		}
+
+		result = append(result, c)
+	}
+
+	return bytesToString(result)
+}
@@ -0,0 +1,284 @@
+package bfh
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EncodeUint64(t *testing.T) {
+	tests := []struct {
+		Name           string
+		Value          uint64
+		ExpectedResult string
+	}{
+		{
+			Name:           "zero",
+			Value:          0,
+			ExpectedResult: "0",
+		},
+		{
+			Name:           "single digit",
+			Value:          31,
+			ExpectedResult: "z",
+		},
+		{
+			Name:           "two digits",
+			Value:          32,
+			ExpectedResult: "10",
+		},
+		{
+			Name:           "grouped",
+			Value:          1234567890,
+			ExpectedResult: "14sc-0pj",
+		},
+		{
+			Name:           "max",
+			Value:          math.MaxUint64,
+			ExpectedResult: "fzzz-zzzz-zzzz-z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			actualResult := EncodeUint64(tt.Value)
+			assert.Equal(t, tt.ExpectedResult, actualResult)
+
+			decoded, err := DecodeUint64(actualResult)
+			require.NoError(t, err)
+			assert.Equal(t, tt.Value, decoded)
+		})
+	}
+}
+
+func Test_DecodeUint64(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedResult uint64
+		}{
+			{
+				Name:           "leading zeros",
+				String:         "0000-00z",
+				ExpectedResult: 31,
+			},
+			{
+				Name:           "without separators",
+				String:         "14sc0pj",
+				ExpectedResult: 1234567890,
+			},
+			{
+				Name:           "max with leading zeros",
+				String:         "000f-zzzz-zzzz-zzzz",
+				ExpectedResult: math.MaxUint64,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := DecodeUint64(tt.String)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedErr    error
+			ExpectedOffset int
+		}{
+			{
+				Name:           "empty",
+				String:         "",
+				ExpectedErr:    ErrInvalidLength,
+				ExpectedOffset: 0,
+			},
+			{
+				Name:           "only separators",
+				String:         "--",
+				ExpectedErr:    ErrInvalidLength,
+				ExpectedOffset: 2,
+			},
+			{
+				Name:           "invalid character",
+				String:         "14sc-0pu",
+				ExpectedErr:    ErrInvalidCharacter,
+				ExpectedOffset: 7,
+			},
+			{
+				Name:           "overflow in the highest digit",
+				String:         "gzzz-zzzz-zzzz-z",
+				ExpectedErr:    ErrOverflow,
+				ExpectedOffset: 15,
+			},
+			{
+				Name:           "overflow in length",
+				String:         "1000-0000-0000-00",
+				ExpectedErr:    ErrOverflow,
+				ExpectedOffset: 16,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := DecodeUint64(tt.String)
+
+				var corruptInputErr *CorruptInputError
+				require.ErrorAs(t, err, &corruptInputErr, fmt.Sprintf("Failing value: %s", tt.String))
+				assert.ErrorIs(t, err, tt.ExpectedErr)
+				assert.Equal(t, tt.ExpectedOffset, corruptInputErr.Offset)
+			})
+		}
+	})
+}
+
+func Test_EncodeUint64Width(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Value          uint64
+			Width          int
+			ExpectedResult string
+		}{
+			{
+				Name:           "leading zeros",
+				Value:          42,
+				Width:          6,
+				ExpectedResult: "0000-1a",
+			},
+			{
+				Name:           "30 bits in 6 digits",
+				Value:          1<<30 - 1,
+				Width:          6,
+				ExpectedResult: "zzzz-zz",
+			},
+			{
+				Name:           "wider than uint64",
+				Value:          math.MaxUint64,
+				Width:          16,
+				ExpectedResult: "000f-zzzz-zzzz-zzzz",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := EncodeUint64Width(tt.Value, tt.Width)
+				require.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+
+				decoded, err := DecodeUint64Width(actualResult, tt.Width)
+				require.NoError(t, err)
+				assert.Equal(t, tt.Value, decoded)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name        string
+			Value       uint64
+			Width       int
+			ExpectedErr error
+		}{
+			{
+				Name:        "does not fit",
+				Value:       1 << 30,
+				Width:       6,
+				ExpectedErr: ErrOverflow,
+			},
+			{
+				Name:        "zero width",
+				Value:       0,
+				Width:       0,
+				ExpectedErr: ErrInvalidLength,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := EncodeUint64Width(tt.Value, tt.Width)
+
+				assert.ErrorIs(t, err, tt.ExpectedErr)
+			})
+		}
+	})
+}
+
+func Test_DecodeUint64Width(t *testing.T) {
+	_, err := DecodeUint64Width("0000-1a", 7)
+	assert.ErrorIs(t, err, ErrInvalidLength)
+
+	_, err = DecodeUint64Width("0000-1u", 6)
+	assert.ErrorIs(t, err, ErrInvalidCharacter)
+}
+
+func Test_BigInt(t *testing.T) {
+	huge, ok := new(big.Int).SetString("123456789012345678901234567890123456789", 10)
+	require.True(t, ok)
+
+	t.Run("round trip", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Value          *big.Int
+			ExpectedResult string
+		}{
+			{
+				Name:           "zero",
+				Value:          big.NewInt(0),
+				ExpectedResult: "0",
+			},
+			{
+				Name:           "same as uint64",
+				Value:          big.NewInt(1234567890),
+				ExpectedResult: EncodeUint64(1234567890),
+			},
+			{
+				Name:           "max uint64",
+				Value:          new(big.Int).SetUint64(math.MaxUint64),
+				ExpectedResult: EncodeUint64(math.MaxUint64),
+			},
+			{
+				Name:           "huge",
+				Value:          huge,
+				ExpectedResult: "2ww3-mtar-0nzv-2tnq-x352-q3k0-8n",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := EncodeBigInt(tt.Value)
+				require.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+
+				decoded, err := DecodeBigInt(actualResult)
+				require.NoError(t, err)
+				assert.Equal(t, 0, tt.Value.Cmp(decoded))
+			})
+		}
+	})
+
+	t.Run("fail to encode", func(t *testing.T) {
+		_, err := EncodeBigInt(big.NewInt(-1))
+		assert.Error(t, err)
+
+		_, err = EncodeBigInt(nil)
+		assert.ErrorIs(t, err, ErrNilInput)
+	})
+
+	t.Run("fail to decode", func(t *testing.T) {
+		_, err := DecodeBigInt("2ww3-mtar-u")
+		assert.ErrorIs(t, err, ErrInvalidCharacter)
+
+		_, err = DecodeBigInt("-")
+		assert.ErrorIs(t, err, ErrInvalidLength)
+	})
+}
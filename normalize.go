@@ -0,0 +1,146 @@
+package bfh
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	// fullWidthOffset is the distance between the full-width forms and their ASCII counterparts
+	fullWidthOffset = 0xfee0
+	fullWidthFirst  = 0xff01
+	fullWidthLast   = 0xff5e
+)
+
+// aliases maps characters easily mistaken for digits to the digit, as defined by Crockford's Base32
+var aliases = map[rune]rune{
+	'o': '0',
+	'i': '1',
+	'l': '1',
+}
+
+// Rewrite describes a single change made while normalizing human input
+type Rewrite struct {
+	// Offset is the byte offset of the rewritten rune in the original input
+	Offset int
+	// From is the rune found in the original input
+	From rune
+	// To is the character it was replaced with, 0 if the rune was dropped
+	To byte
+}
+
+// Normalize turns human input into a string of canonical characters
+// Uppercase characters and the ambiguous characters o, i and l are mapped to the alphabet, full-width characters are
+// mapped to their ASCII counterparts, while all dashes and whitespace are dropped.
+func Normalize(str string) (string, error) {
+	return StdEncoding.Normalize(str)
+}
+
+// DecodeLenientStr normalizes human input before decoding it, also reporting what was rewritten
+// Separators are not reported as rewrites.
+func DecodeLenientStr(str string) ([]byte, []Rewrite, error) {
+	return StdEncoding.DecodeLenientStr(str)
+}
+
+// DecodeStrictLenientStr normalizes human input before decoding it in strict mode, also reporting what was rewritten
+// Separators are not reported as rewrites.
+func DecodeStrictLenientStr(str string) ([]byte, []Rewrite, error) {
+	return StdEncoding.DecodeStrictLenientStr(str)
+}
+
+// Normalize turns human input into a string of canonical characters of the alphabet
+func (enc *Encoding) Normalize(str string) (string, error) {
+	normalized, _, err := enc.normalize(str)
+
+	return normalized, err
+}
+
+// DecodeLenientStr normalizes human input before decoding it, also reporting what was rewritten
+func (enc *Encoding) DecodeLenientStr(str string) ([]byte, []Rewrite, error) {
+	normalized, rewrites, err := enc.normalize(str)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := enc.DecodeStr(normalized)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, rewrites, nil
+}
+
+// DecodeStrictLenientStr normalizes human input before decoding it in strict mode, also reporting what was rewritten
+func (enc *Encoding) DecodeStrictLenientStr(str string) ([]byte, []Rewrite, error) {
+	normalized, rewrites, err := enc.normalize(str)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := enc.DecodeStrictStr(normalized)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return data, rewrites, nil
+}
+
+func (enc *Encoding) normalize(str string) (string, []Rewrite, error) {
+	var (
+		result   = make([]byte, 0, len(str))
+		rewrites []Rewrite
+	)
+
+	for offset, r := range str {
+		if r == rune(enc.separator) {
+			continue
+		}
+
+		if r == utf8.RuneError {
+			return "", nil, newCorruptInputError(str, offset, ErrInvalidCharacter)
+		}
+
+		if isDroppable(r) {
+			rewrites = append(rewrites, Rewrite{Offset: offset, From: r})
+			continue
+		}
+
+		ch, ok := enc.canonicalChar(r)
+		if !ok {
+			return "", nil, newCorruptInputError(str, offset, ErrInvalidCharacter)
+		}
+
+		if rune(ch) != r {
+			rewrites = append(rewrites, Rewrite{Offset: offset, From: r, To: ch})
+		}
+
+		result = append(result, ch)
+	}
+
+	return string(result), rewrites, nil
+}
+
+// canonicalChar returns the character of the alphabet a rune of human input stands for
+func (enc *Encoding) canonicalChar(r rune) (byte, bool) {
+	if r >= fullWidthFirst && r <= fullWidthLast {
+		r -= fullWidthOffset
+	}
+
+	for _, c := range [...]rune{r, unicode.ToLower(r), unicode.ToUpper(r), aliases[unicode.ToLower(r)]} {
+		if c > 0 && c < utf8.RuneSelf && enc.decodeMap[c] != invalidDigit {
+			return byte(c), true
+		}
+	}
+
+	return 0, false
+}
+
+// isDroppable returns true for any kind of dash and whitespace, including the invisible ones
+func isDroppable(r rune) bool {
+	switch r {
+	case '\u2212', '\u200b', '\u200c', '\u200d', '\u2060', '\ufeff':
+		return true
+	}
+
+	return unicode.Is(unicode.Pd, r) || unicode.IsSpace(r)
+}
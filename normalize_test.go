@@ -0,0 +1,186 @@
+package bfh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Normalize(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedResult string
+		}{
+			{
+				Name:           "empty",
+				String:         "",
+				ExpectedResult: "",
+			},
+			{
+				Name:           "canonical",
+				String:         "4-zwga-e07x-2400-0000",
+				ExpectedResult: "4zwgae07x24000000",
+			},
+			{
+				Name:           "uppercase",
+				String:         "4-ZWGA-E07X-2400-0000",
+				ExpectedResult: "4zwgae07x24000000",
+			},
+			{
+				Name:           "aliases",
+				String:         "4-zwga-eO7x-24oo-0000-IiLl",
+				ExpectedResult: "4zwgae07x240000001111",
+			},
+			{
+				Name:           "unicode dashes",
+				String:         "4–zwga—e07x−2400‐0000",
+				ExpectedResult: "4zwgae07x24000000",
+			},
+			{
+				Name:           "whitespace",
+				String:         " 4 zwga e07x\n2400\t0000\u200b\r\n",
+				ExpectedResult: "4zwgae07x24000000",
+			},
+			{
+				Name:           "full-width",
+				String:         "４－Ｚｗｇａ-e07x-2400-0000",
+				ExpectedResult: "4zwgae07x24000000",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := Normalize(tt.String)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			String string
+		}{
+			{
+				Name:   "u is not an alias",
+				String: "4-zwga-e07u-2400-0000",
+			},
+			{
+				Name:   "punctuation",
+				String: "4-zwga-e07x-2400-0000.",
+			},
+			{
+				Name:   "non-latin letter",
+				String: "4-zwga-e07x-2400-000ö",
+			},
+			{
+				Name:   "invalid utf-8",
+				String: "4-zwga-e07x-2400-000\xff",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := Normalize(tt.String)
+
+				assert.Error(t, err, fmt.Sprintf("Failing value: %s", tt.String))
+			})
+		}
+	})
+
+	t.Run("uppercase alphabet", func(t *testing.T) {
+		enc := MustNewEncoding("0123456789ABCDEFGHJKMNPQRSTVWXYZ")
+
+		actualResult, err := enc.Normalize("4-zwga-e07x-24oo-0000")
+
+		assert.NoError(t, err)
+		assert.Equal(t, "4ZWGAE07X24000000", actualResult)
+	})
+}
+
+func Test_DecodeLenientStr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name             string
+			String           string
+			ExpectedResult   []byte
+			ExpectedRewrites []Rewrite
+		}{
+			{
+				Name:           "canonical",
+				String:         "4-zwga-e07x-2400-0000",
+				ExpectedResult: []byte{255, 32, 167, 0, 253, 17},
+			},
+			{
+				Name:           "rewritten",
+				String:         "4 ZWGA–eO7x-2400-0000",
+				ExpectedResult: []byte{255, 32, 167, 0, 253, 17},
+				ExpectedRewrites: []Rewrite{
+					{Offset: 1, From: ' '},
+					{Offset: 2, From: 'Z', To: 'z'},
+					{Offset: 3, From: 'W', To: 'w'},
+					{Offset: 4, From: 'G', To: 'g'},
+					{Offset: 5, From: 'A', To: 'a'},
+					{Offset: 6, From: '–'},
+					{Offset: 10, From: 'O', To: '0'},
+				},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, actualRewrites, err := DecodeLenientStr(tt.String)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+				assert.Equal(t, tt.ExpectedRewrites, actualRewrites)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			String string
+		}{
+			{
+				Name:   "invalid character",
+				String: "4-uwga-e07x-2400-0000",
+			},
+			{
+				Name:   "wrong length",
+				String: "0-ZWGA-E0",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, _, err := DecodeLenientStr(tt.String)
+
+				assert.Error(t, err, fmt.Sprintf("Failing value: %s", tt.String))
+			})
+		}
+	})
+}
+
+func Test_DecodeStrictLenientStr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		actualResult, actualRewrites, err := DecodeStrictLenientStr("ZWGA E07X")
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte{255, 32, 167, 0, 253}, actualResult)
+		assert.Len(t, actualRewrites, 7)
+	})
+
+	t.Run("fail on wrong length", func(t *testing.T) {
+		_, _, err := DecodeStrictLenientStr("ZWGA E0")
+
+		assert.Error(t, err)
+	})
+}
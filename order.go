@@ -0,0 +1,100 @@
+package bfh
+
+import (
+	"errors"
+)
+
+const errMsgAlphabetNotOrdered = "alphabet must be in ascending order to preserve the order of the data"
+
+// EncodeOrderedStr encodes binary data of any length into a string sorting the same way as the data
+func EncodeOrderedStr(b []byte) (string, error) {
+	return StdEncoding.EncodeOrderedStr(b)
+}
+
+// DecodeOrderedStr decodes some binary data from a human readable string encoded by EncodeOrderedStr
+func DecodeOrderedStr(str string) ([]byte, error) {
+	return StdEncoding.DecodeOrderedStr(str)
+}
+
+// Compare returns -1, 0 or +1 depending on whether the data encoded in a sorts before, equal to or after the data
+// encoded in b, without decoding either of them
+func Compare(a, b string) int {
+	return StdEncoding.Compare(a, b)
+}
+
+// EncodeOrderedStr encodes binary data of any length into a string sorting the same way as the data
+// The ordered form is the raw mode form: there is no leading padding digit and the zero bits filling the last digit
+// never sort after the data of a longer string, so comparing two strings byte by byte gives the same result as
+// comparing the data, shorter data sorting first when it is a prefix of the other. It requires an alphabet in
+// ascending order, like the default one.
+func (enc *Encoding) EncodeOrderedStr(b []byte) (string, error) {
+	if !enc.isOrdered() {
+		return "", errors.New(errMsgAlphabetNotOrdered)
+	}
+
+	return enc.EncodeRawStr(b)
+}
+
+// DecodeOrderedStr decodes some binary data from a human readable string encoded by EncodeOrderedStr
+func (enc *Encoding) DecodeOrderedStr(str string) ([]byte, error) {
+	return enc.DecodeRawStr(str)
+}
+
+// Compare returns -1, 0 or +1 depending on whether the data encoded in a sorts before, equal to or after the data
+// encoded in b, without decoding either of them
+// Both strings must be in raw mode, as produced by EncodeOrderedStr, or in strict mode, which is the same for data with
+// a length dividable by 5. Separators are ignored and characters not part of the alphabet sort after all digits. Unlike
+// comparing the strings directly, it also works for alphabets not in ascending order.
+func (enc *Encoding) Compare(a, b string) int {
+	i, j := 0, 0
+
+	for {
+		i = enc.skipSeparators(a, i)
+		j = enc.skipSeparators(b, j)
+
+		switch {
+		case i == len(a) && j == len(b):
+			return 0
+		case i == len(a):
+			return -1
+		case j == len(b):
+			return 1
+		}
+
+		if c := enc.compareChars(a[i], b[j]); c != 0 {
+			return c
+		}
+
+		i++
+		j++
+	}
+}
+
+// isOrdered returns true if the characters of the alphabet are in ascending order
+func (enc *Encoding) isOrdered() bool {
+	for i := 1; i < len(enc.alphabet); i++ {
+		if enc.alphabet[i-1] > enc.alphabet[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// compareChars compares two characters by the value of the digits they represent
+// Characters not part of the alphabet sort after all digits and by their byte value among themselves.
+func (enc *Encoding) compareChars(a, b byte) int {
+	va, vb := enc.decodeMap[a], enc.decodeMap[b]
+	if va == vb && va == invalidDigit {
+		va, vb = a, b
+	}
+
+	switch {
+	case va < vb:
+		return -1
+	case va > vb:
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,250 @@
+package bfh
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+	"testing/quick"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// orderedData returns data of up to 12 bytes only made of a few byte values, so that many values share a prefix
+func orderedData(n int) [][]byte {
+	r := rand.New(rand.NewSource(int64(n)))
+	values := []byte{0, 1, 127, 128, 254, 255}
+
+	data := make([][]byte, n)
+	for i := range data {
+		data[i] = make([]byte, r.Intn(13))
+		for j := range data[i] {
+			data[i][j] = values[r.Intn(len(values))]
+		}
+	}
+
+	return data
+}
+
+// sortsLikeData returns true if sorting the encoded data gives the same strings as encoding the sorted data, both by
+// comparing the strings directly and using Compare
+func sortsLikeData(data [][]byte) bool {
+	sorted := make([][]byte, len(data))
+	copy(sorted, data)
+	sort.Slice(sorted, func(i, j int) bool { return bytes.Compare(sorted[i], sorted[j]) < 0 })
+
+	encodedSorted := make([]string, len(sorted))
+	for i, b := range sorted {
+		encodedSorted[i], _ = EncodeOrderedStr(b)
+	}
+
+	encoded := make([]string, len(data))
+	for i, b := range data {
+		encoded[i], _ = EncodeOrderedStr(b)
+	}
+
+	byCompare := make([]string, len(encoded))
+	copy(byCompare, encoded)
+	sort.Strings(encoded)
+	sort.Slice(byCompare, func(i, j int) bool { return Compare(byCompare[i], byCompare[j]) < 0 })
+
+	for i := range encoded {
+		if encoded[i] != encodedSorted[i] || byCompare[i] != encodedSorted[i] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func Test_EncodeOrderedStr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Bytes          []byte
+			ExpectedResult string
+		}{
+			{
+				Name:           "empty",
+				Bytes:          []byte{},
+				ExpectedResult: "",
+			},
+			{
+				Name:           "1 byte",
+				Bytes:          []byte{255},
+				ExpectedResult: "zw",
+			},
+			{
+				Name:           "5 bytes",
+				Bytes:          []byte{255, 32, 167, 0, 253},
+				ExpectedResult: "zwga-e07x",
+			},
+			{
+				Name:           "6 bytes",
+				Bytes:          []byte{255, 32, 167, 0, 253, 17},
+				ExpectedResult: "zwga-e07x-24",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := EncodeOrderedStr(tt.Bytes)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("fail on nil", func(t *testing.T) {
+		_, err := EncodeOrderedStr(nil)
+
+		assert.ErrorIs(t, err, ErrNilInput)
+	})
+
+	t.Run("fail on alphabet not in ascending order", func(t *testing.T) {
+		enc := MustNewEncoding("zyxwvtsrqpnmkjhgfedcba9876543210")
+
+		_, err := enc.EncodeOrderedStr([]byte{255})
+
+		assert.EqualError(t, err, errMsgAlphabetNotOrdered)
+	})
+
+	t.Run("keeps the order of the data", func(t *testing.T) {
+		assert.True(t, sortsLikeData(orderedData(1000)))
+	})
+
+	t.Run("keeps the order of random data", func(t *testing.T) {
+		assert.NoError(t, quick.Check(sortsLikeData, nil))
+	})
+}
+
+func Test_DecodeOrderedStr(t *testing.T) {
+	t.Run("round trip", func(t *testing.T) {
+		for _, b := range orderedData(100) {
+			encoded, err := EncodeOrderedStr(b)
+			require.NoError(t, err)
+
+			actualResult, err := DecodeOrderedStr(encoded)
+
+			assert.NoError(t, err)
+			assert.Equal(t, b, actualResult, fmt.Sprintf("Failing value: %s", encoded))
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			String string
+		}{
+			{
+				Name:   "padding header",
+				String: "4-zwga-e07x-2400-0000",
+			},
+			{
+				Name:   "non-zero trailing bits",
+				String: "zz",
+			},
+			{
+				Name:   "invalid character",
+				String: "zu",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := DecodeOrderedStr(tt.String)
+
+				assert.Error(t, err, fmt.Sprintf("Failing value: %s", tt.String))
+			})
+		}
+	})
+}
+
+func Test_Compare(t *testing.T) {
+	tests := []struct {
+		Name           string
+		A              string
+		B              string
+		ExpectedResult int
+	}{
+		{
+			Name:           "both empty",
+			A:              "",
+			B:              "",
+			ExpectedResult: 0,
+		},
+		{
+			Name:           "empty first",
+			A:              "",
+			B:              "00",
+			ExpectedResult: -1,
+		},
+		{
+			Name:           "equal",
+			A:              "zwga-e07x-24",
+			B:              "zwga-e07x-24",
+			ExpectedResult: 0,
+		},
+		{
+			Name:           "separators are ignored",
+			A:              "zwga-e07x-24",
+			B:              "zwgae07x24",
+			ExpectedResult: 0,
+		},
+		{
+			Name:           "prefix first",
+			A:              "zwga-e07x",
+			B:              "zwga-e07x-00",
+			ExpectedResult: -1,
+		},
+		{
+			Name:           "greater data last",
+			A:              "zwga-e07x-24",
+			B:              "zwga-e07x-00",
+			ExpectedResult: 1,
+		},
+		{
+			Name:           "digit values",
+			A:              "9z",
+			B:              "a0",
+			ExpectedResult: -1,
+		},
+		{
+			Name:           "invalid character after digits",
+			A:              "u0",
+			B:              "z0",
+			ExpectedResult: 1,
+		},
+		{
+			Name:           "invalid characters by byte value",
+			A:              "u0",
+			B:              "i0",
+			ExpectedResult: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.ExpectedResult, Compare(tt.A, tt.B), fmt.Sprintf("Failing value: %s, %s", tt.A, tt.B))
+			assert.Equal(t, -tt.ExpectedResult, Compare(tt.B, tt.A), fmt.Sprintf("Failing value: %s, %s", tt.B, tt.A))
+		})
+	}
+
+	t.Run("alphabet not in ascending order", func(t *testing.T) {
+		enc := MustNewEncoding("zyxwvtsrqpnmkjhgfedcba9876543210")
+
+		for _, pair := range [][2][]byte{{{0}, {1}}, {{1, 2}, {1, 3}}, {{127, 255}, {128}}} {
+			a, err := enc.EncodeRawStr(pair[0])
+			require.NoError(t, err)
+			b, err := enc.EncodeRawStr(pair[1])
+			require.NoError(t, err)
+
+			assert.Equal(t, -1, enc.Compare(a, b), fmt.Sprintf("Failing value: %s, %s", a, b))
+			assert.Equal(t, 1, strings.Compare(a, b), fmt.Sprintf("Failing value: %s, %s", a, b))
+		}
+	})
+}
@@ -0,0 +1,325 @@
+package bfh
+
+import (
+	"context"
+	"errors"
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// parallelChunkPackets is the number of packets encoded or decoded by a worker at a time, data not filling two chunks
+// is processed sequentially
+const parallelChunkPackets = 1 << 15
+
+// errIrregularLayout is returned by a worker if its chunk of an encoded string does not hold the expected number of
+// digits, as the separators are not where they would be in a well-formatted string
+var errIrregularLayout = errors.New("irregular layout")
+
+// EncodeParallel encodes binary data into a human readable text using multiple goroutines
+// The result is the same as that of Encode. Workers is the maximum number of goroutines used, GOMAXPROCS if it is not
+// positive.
+func EncodeParallel(ctx context.Context, b []byte, workers int) ([]byte, error) {
+	return StdEncoding.EncodeParallel(ctx, b, workers)
+}
+
+// EncodeStrictParallel encodes binary data into a strict human readable text using multiple goroutines
+func EncodeStrictParallel(ctx context.Context, b []byte, workers int) ([]byte, error) {
+	return StdEncoding.EncodeStrictParallel(ctx, b, workers)
+}
+
+// DecodeParallel decodes some binary data from a human readable string using multiple goroutines
+// The result, including the errors, is the same as that of DecodeStr.
+func DecodeParallel(ctx context.Context, str string, workers int) ([]byte, error) {
+	return StdEncoding.DecodeParallel(ctx, str, workers)
+}
+
+// DecodeStrictParallel decodes some binary data from a strict human readable string using multiple goroutines
+func DecodeStrictParallel(ctx context.Context, str string, workers int) ([]byte, error) {
+	return StdEncoding.DecodeStrictParallel(ctx, str, workers)
+}
+
+// EncodeParallel encodes binary data into a human readable text using multiple goroutines
+// Data is split into chunks on packet boundaries, which are encoded directly into their place in the result. It returns
+// the error of ctx if it is done before all chunks are encoded.
+func (enc *Encoding) EncodeParallel(ctx context.Context, b []byte, workers int) ([]byte, error) {
+	if b == nil {
+		return nil, ErrNilInput
+	}
+
+	if !enc.paddingHeader {
+		return enc.EncodeStrictParallel(ctx, b, workers)
+	}
+
+	result := make([]byte, enc.EncodedLen(len(b)))
+
+	offset := enc.headerLength()
+	result[0] = enc.alphabet[(5-len(b)%5)%5]
+	if offset > 1 {
+		result[1] = enc.separator
+	}
+
+	if err := enc.encodeParallel(ctx, result[offset:], b, workers); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// EncodeStrictParallel encodes binary data into a strict human readable text using multiple goroutines
+func (enc *Encoding) EncodeStrictParallel(ctx context.Context, b []byte, workers int) ([]byte, error) {
+	if b == nil {
+		return nil, ErrNilInput
+	}
+
+	if len(b)%5 != 0 {
+		return nil, strictLengthError()
+	}
+
+	result := make([]byte, enc.EncodedStrictLen(len(b)))
+
+	if err := enc.encodeParallel(ctx, result, b, workers); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DecodeParallel decodes some binary data from a human readable string using multiple goroutines
+// Strings are split into chunks on packet boundaries, assuming a well-formatted string, which are decoded directly
+// into their place in the result. Strings with separators elsewhere are decoded sequentially. It returns the error of
+// ctx if it is done before all chunks are decoded.
+func (enc *Encoding) DecodeParallel(ctx context.Context, str string, workers int) ([]byte, error) {
+	if !enc.paddingHeader {
+		return enc.DecodeStrictParallel(ctx, str, workers)
+	}
+
+	first, padding, digitCount, err := enc.normalHeader(str)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := enc.decodeParallel(ctx, str, first+1, digitCount, workers)
+	if err != nil {
+		return nil, err
+	}
+
+	return enc.removePadding(nil, result, str, first, padding)
+}
+
+// DecodeStrictParallel decodes some binary data from a strict human readable string using multiple goroutines
+func (enc *Encoding) DecodeStrictParallel(ctx context.Context, str string, workers int) ([]byte, error) {
+	// separators are not needed, they only help readability
+	digitCount := len(str) - countByte(str, enc.separator)
+
+	if digitCount%8 != 0 {
+		return nil, newCorruptInputError(str, len(str), ErrInvalidLength)
+	}
+
+	return enc.decodeParallel(ctx, str, 0, digitCount, workers)
+}
+
+// encodeParallel writes the digits representing b into result, which must be exactly as long as needed for them
+func (enc *Encoding) encodeParallel(ctx context.Context, result, b []byte, workers int) error {
+	chunkPackets := enc.chunkPackets()
+	chunkLength := chunkPackets * packetLength
+
+	return runParallel(ctx, (len(b)+chunkLength-1)/chunkLength, workers, func(chunk int) error {
+		start := chunk * chunkLength
+		end := start + chunkLength
+		if end > len(b) {
+			end = len(b)
+		}
+
+		// chunks start at group boundaries, therefore the separator preceding them is written here
+		from := enc.digitPosition(chunk * chunkPackets * packetDigits)
+		if chunk > 0 && enc.groupLength > 0 {
+			result[from-1] = enc.separator
+		}
+
+		to := len(result)
+		if end < len(b) {
+			to = enc.digitPosition((chunk+1)*chunkPackets*packetDigits) - enc.separatorLength()
+		}
+
+		enc.encode(b[start:end], result[from:to], 0)
+
+		return nil
+	})
+}
+
+// decodeParallel decodes digitCount digits of str starting at offset, falling back to decodeSequential if the string
+// is not well-formatted
+func (enc *Encoding) decodeParallel(ctx context.Context, str string, offset, digitCount, workers int) ([]byte, error) {
+	result := make([]byte, digitCount*5/8)
+
+	chunkPackets := enc.chunkPackets()
+	chunkDigits := chunkPackets * packetDigits
+	start := enc.skipSeparators(str, offset)
+
+	err := runParallel(ctx, (digitCount+chunkDigits-1)/chunkDigits, workers, func(chunk int) error {
+		// chunks include the separator preceding them, so that together they cover the whole string
+		from := offset
+		if chunk > 0 {
+			from = start + enc.digitPosition(chunk*chunkDigits) - enc.separatorLength()
+		}
+
+		to := len(str)
+		if (chunk+1)*chunkDigits < digitCount {
+			to = start + enc.digitPosition((chunk+1)*chunkDigits) - enc.separatorLength()
+		}
+
+		chunkLength := chunkPackets * packetLength
+		end := (chunk + 1) * chunkLength
+		if end > len(result) {
+			end = len(result)
+		}
+
+		// decode appends to data, which has just enough capacity for the chunk, therefore it writes into result
+		data := result[chunk*chunkLength : chunk*chunkLength : end]
+		digits := cap(data) / 5 * 8
+
+		if from > to || to > len(str) || to-from-countByte(str[from:to], enc.separator) != digits {
+			return errIrregularLayout
+		}
+
+		_, err := enc.decode(data, str[:to], from, digits)
+
+		return err
+	})
+
+	if errors.Is(err, errIrregularLayout) {
+		return enc.decodeSequential(ctx, str, offset, digitCount)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// decodeSequential decodes digitCount digits of str starting at offset one chunk after the other, wherever the
+// separators are, returning the error of ctx if it is done before all chunks are decoded
+func (enc *Encoding) decodeSequential(ctx context.Context, str string, offset, digitCount int) ([]byte, error) {
+	result := make([]byte, 0, digitCount*5/8)
+	chunkDigits := enc.chunkPackets() * packetDigits
+
+	for i := offset; digitCount > 0; {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		digits := chunkDigits
+		if digits > digitCount {
+			digits = digitCount
+		}
+
+		// the chunk ends after its last digit, every character other than the separator counting as one
+		end := i
+		for n := 0; n < digits; end++ {
+			if str[end] != enc.separator {
+				n++
+			}
+		}
+
+		var err error
+		if result, err = enc.decode(result, str[:end], i, digits); err != nil {
+			return nil, err
+		}
+
+		i = end
+		digitCount -= digits
+	}
+
+	return result, nil
+}
+
+// chunkPackets returns the number of packets in a chunk, which is some multiple of the group length, so that chunks
+// start at group boundaries
+func (enc *Encoding) chunkPackets() int {
+	if enc.groupLength == 0 {
+		return parallelChunkPackets
+	}
+
+	return (parallelChunkPackets + enc.groupLength - 1) / enc.groupLength * enc.groupLength
+}
+
+// digitPosition returns the position of a digit in a well-formatted sequence of digits
+func (enc *Encoding) digitPosition(digit int) int {
+	if enc.groupLength == 0 {
+		return digit
+	}
+
+	return digit + digit/enc.groupLength
+}
+
+// separatorLength returns the number of separators between groups
+func (enc *Encoding) separatorLength() int {
+	if enc.groupLength == 0 {
+		return 0
+	}
+
+	return 1
+}
+
+// runParallel calls job for each of the jobs on up to workers goroutines, returning the error of the first job failing
+// in the order of the jobs, or the error of ctx if it is done before all jobs are finished
+func runParallel(ctx context.Context, jobs, workers int, job func(int) error) error {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+
+	if workers > jobs {
+		workers = jobs
+	}
+
+	if workers <= 1 {
+		for i := 0; i < jobs; i++ {
+			if err := ctx.Err(); err != nil {
+				return err
+			}
+
+			if err := job(i); err != nil {
+				return err
+			}
+		}
+
+		return ctx.Err()
+	}
+
+	errs := make([]error, jobs)
+	next := int64(-1)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for {
+				i := int(atomic.AddInt64(&next, 1))
+				if i >= jobs || ctx.Err() != nil {
+					return
+				}
+
+				errs[i] = job(i)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
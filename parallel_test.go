@@ -0,0 +1,257 @@
+package bfh
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	// parallelChunkLength is the number of bytes in a chunk of StdEncoding
+	parallelChunkLength = parallelChunkPackets * packetLength
+	// largeBenchmarksEnv is the environment variable enabling the benchmarks of 64 MiB and more
+	largeBenchmarksEnv = "BFH_LARGE_BENCHMARKS"
+)
+
+func randomData(n int) []byte {
+	data := make([]byte, n)
+	rand.New(rand.NewSource(int64(n))).Read(data)
+
+	return data
+}
+
+func Test_EncodeParallel(t *testing.T) {
+	encodings := []struct {
+		Name     string
+		Encoding *Encoding
+	}{
+		{Name: "standard", Encoding: StdEncoding},
+		{Name: "ungrouped", Encoding: MustNewEncoding(digits, WithGroupLength(0))},
+		{Name: "groups of 3", Encoding: MustNewEncoding(digits, WithGroupLength(3))},
+		{Name: "no padding header", Encoding: MustNewEncoding(digits, WithPaddingHeader(false))},
+	}
+
+	lengths := []int{0, 1, 5, parallelChunkLength - 1, parallelChunkLength, parallelChunkLength + 1, 3*parallelChunkLength + 3}
+
+	for _, e := range encodings {
+		for _, n := range lengths {
+			for _, workers := range []int{0, 1, 3} {
+				t.Run(fmt.Sprintf("%s/%d bytes/%d workers", e.Name, n, workers), func(t *testing.T) {
+					data := randomData(n)
+					if !e.Encoding.paddingHeader {
+						data = data[:n/5*5]
+					}
+
+					expected, err := e.Encoding.Encode(data)
+					require.NoError(t, err)
+
+					actual, err := e.Encoding.EncodeParallel(context.Background(), data, workers)
+					require.NoError(t, err)
+					assert.Equal(t, expected, actual)
+
+					decoded, err := e.Encoding.DecodeParallel(context.Background(), string(actual), workers)
+					require.NoError(t, err)
+					assert.Equal(t, data, decoded)
+				})
+			}
+		}
+	}
+}
+
+func Test_EncodeStrictParallel(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		data := randomData(2*parallelChunkLength + 5)
+
+		expected, err := EncodeStrict(data)
+		require.NoError(t, err)
+
+		actual, err := EncodeStrictParallel(context.Background(), data, 0)
+		require.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		decoded, err := DecodeStrictParallel(context.Background(), string(actual), 0)
+		require.NoError(t, err)
+		assert.Equal(t, data, decoded)
+	})
+
+	t.Run("fail on invalid length", func(t *testing.T) {
+		_, err := EncodeStrictParallel(context.Background(), randomData(parallelChunkLength+1), 0)
+
+		assert.ErrorIs(t, err, ErrInvalidLength)
+	})
+
+	t.Run("fail on nil", func(t *testing.T) {
+		_, err := EncodeParallel(context.Background(), nil, 0)
+
+		assert.ErrorIs(t, err, ErrNilInput)
+	})
+}
+
+func Test_DecodeParallel(t *testing.T) {
+	data := randomData(3*parallelChunkLength + 3)
+	encoded, err := EncodeStr(data)
+	require.NoError(t, err)
+
+	t.Run("irregular layout", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			String string
+		}{
+			{
+				Name:   "without separators",
+				String: RemoveByte(encoded, separator),
+			},
+			{
+				Name:   "with an extra separator",
+				String: encoded[:parallelChunkLength] + "-" + encoded[parallelChunkLength:],
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actual, err := DecodeParallel(context.Background(), tt.String, 0)
+
+				require.NoError(t, err)
+				assert.Equal(t, data, actual)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name     string
+			Encoding *Encoding
+			String   string
+		}{
+			{
+				Name:     "invalid character in a later chunk",
+				Encoding: StdEncoding,
+				String:   encoded[:2*parallelChunkLength] + "u" + encoded[2*parallelChunkLength+1:],
+			},
+			{
+				Name:     "invalid characters in multiple chunks",
+				Encoding: StdEncoding,
+				String:   encoded[:10] + "u" + encoded[11:3*parallelChunkLength] + "u" + encoded[3*parallelChunkLength+1:],
+			},
+			{
+				Name:     "invalid character with irregular layout",
+				Encoding: StdEncoding,
+				String:   strings.Replace(RemoveByte(encoded, separator), "0", "u", 1),
+			},
+			{
+				Name:     "invalid length",
+				Encoding: StdEncoding,
+				String:   encoded[:len(encoded)-1],
+			},
+			{
+				Name:     "invalid padding",
+				Encoding: StdEncoding,
+				String:   "9" + encoded[1:],
+			},
+			{
+				Name:     "non-canonical",
+				Encoding: CanonicalEncoding,
+				String:   encoded[:len(encoded)-1] + "z",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, expectedErr := tt.Encoding.DecodeStr(tt.String)
+				require.Error(t, expectedErr)
+
+				_, err := tt.Encoding.DecodeParallel(context.Background(), tt.String, 0)
+
+				assert.Equal(t, expectedErr, err)
+			})
+		}
+	})
+
+	t.Run("fail on invalid strict length", func(t *testing.T) {
+		_, err := DecodeStrictParallel(context.Background(), "zwga-e07", 0)
+
+		assert.ErrorIs(t, err, ErrInvalidLength)
+	})
+}
+
+func Test_Parallel_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	data := randomData(3 * parallelChunkLength)
+
+	_, err := EncodeParallel(ctx, data, 0)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	_, err = EncodeParallel(ctx, data, 1)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	encoded, err := EncodeStr(data)
+	require.NoError(t, err)
+
+	_, err = DecodeParallel(ctx, encoded, 0)
+	assert.ErrorIs(t, err, context.Canceled)
+
+	// strings which are not well-formatted are decoded sequentially, which must be cancellable as well
+	unformatted := RemoveByte(encoded, separator)
+
+	_, err = StdEncoding.decodeSequential(ctx, unformatted, 1, len(unformatted)-1)
+	assert.ErrorIs(t, err, context.Canceled)
+}
+
+func benchmarkParallel(b *testing.B, n int) {
+	// large benchmarks need several GiB of memory, especially with the race detector, so they only run on request
+	if n >= 64<<20 && os.Getenv(largeBenchmarksEnv) == "" {
+		b.Skipf("skipping large benchmark, set %s=1 to run it", largeBenchmarksEnv)
+	}
+
+	data := randomData(n)
+	encoded, err := EncodeStr(data)
+	require.NoError(b, err)
+
+	b.Run("Encode", func(b *testing.B) {
+		b.SetBytes(int64(n))
+		for i := 0; i < b.N; i++ {
+			_, _ = Encode(data)
+		}
+	})
+
+	b.Run("EncodeParallel", func(b *testing.B) {
+		b.SetBytes(int64(n))
+		for i := 0; i < b.N; i++ {
+			_, _ = EncodeParallel(context.Background(), data, 0)
+		}
+	})
+
+	b.Run("DecodeStr", func(b *testing.B) {
+		b.SetBytes(int64(n))
+		for i := 0; i < b.N; i++ {
+			_, _ = DecodeStr(encoded)
+		}
+	})
+
+	b.Run("DecodeParallel", func(b *testing.B) {
+		b.SetBytes(int64(n))
+		for i := 0; i < b.N; i++ {
+			_, _ = DecodeParallel(context.Background(), encoded, 0)
+		}
+	})
+}
+
+func Benchmark_Parallel_1MiB(b *testing.B) {
+	benchmarkParallel(b, 1<<20)
+}
+
+func Benchmark_Parallel_64MiB(b *testing.B) {
+	benchmarkParallel(b, 64<<20)
+}
+
+func Benchmark_Parallel_512MiB(b *testing.B) {
+	benchmarkParallel(b, 512<<20)
+}
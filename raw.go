@@ -0,0 +1,205 @@
+package bfh
+
+// rawTrailingBits is indexed by the number of digits of the last, partial packet in raw mode and holds the number of
+// bits of the last digit not representing any data, -1 if no data length results in that many digits
+var rawTrailingBits = [packetDigits]int{0, -1, 2, -1, 4, 1, -1, 3}
+
+// EncodeRaw encodes binary data of any length into the minimum number of characters without a padding digit
+func EncodeRaw(b []byte) ([]byte, error) {
+	return StdEncoding.EncodeRaw(b)
+}
+
+// EncodeRawStr encodes binary data of any length into the minimum number of characters without a padding digit
+func EncodeRawStr(b []byte) (string, error) {
+	return StdEncoding.EncodeRawStr(b)
+}
+
+// DecodeRaw decodes some binary data from a human readable text encoded in raw mode
+func DecodeRaw(b []byte) ([]byte, error) {
+	return StdEncoding.DecodeRaw(b)
+}
+
+// DecodeRawStr decodes some binary data from a human readable string encoded in raw mode
+func DecodeRawStr(str string) ([]byte, error) {
+	return StdEncoding.DecodeRawStr(str)
+}
+
+// IsRaw returns true if the string is a well-formatted raw mode string
+func IsRaw(str string) bool {
+	return StdEncoding.IsRaw(str)
+}
+
+// ValidateRaw returns nil if the string is a well-formatted raw mode string, otherwise the reason why it is not
+// It is the error returning counterpart of IsRaw.
+func ValidateRaw(str string) error {
+	return StdEncoding.ValidateRaw(str)
+}
+
+// EncodedRawLen returns the length of the raw mode encoding of n bytes of data
+func EncodedRawLen(n int) int {
+	return StdEncoding.EncodedRawLen(n)
+}
+
+// AppendEncodeRaw appends the raw mode encoding of src to dst and returns the extended buffer
+func AppendEncodeRaw(dst, src []byte) []byte {
+	return StdEncoding.AppendEncodeRaw(dst, src)
+}
+
+// AppendDecodeRaw appends the data decoded from the raw mode src to dst and returns the extended buffer
+func AppendDecodeRaw(dst, src []byte) ([]byte, error) {
+	return StdEncoding.AppendDecodeRaw(dst, src)
+}
+
+// EncodeRaw encodes binary data of any length into the minimum number of characters without a padding digit
+// Just like base32.NoPadding, n bytes are encoded into ceil(8n/5) digits, the length of the data being inferred from
+// the number of digits on decoding.
+func (enc *Encoding) EncodeRaw(b []byte) ([]byte, error) {
+	if b == nil {
+		return nil, ErrNilInput
+	}
+
+	// encode writes whole packets, so there must be enough room for the last one
+	return enc.AppendEncodeRaw(make([]byte, 0, enc.groupedLength((len(b)+4)/5*8)), b), nil
+}
+
+// EncodeRawStr encodes binary data of any length into the minimum number of characters without a padding digit
+func (enc *Encoding) EncodeRawStr(b []byte) (string, error) {
+	result, err := enc.EncodeRaw(b)
+	if err != nil {
+		return "", err
+	}
+
+	// result is not referenced anywhere else, therefore there is no need to copy it
+	return bytesToString(result), nil
+}
+
+// DecodeRaw decodes some binary data from a human readable text encoded in raw mode
+func (enc *Encoding) DecodeRaw(b []byte) ([]byte, error) {
+	data, err := enc.AppendDecodeRaw(make([]byte, 0, len(b)*5/8), b)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// DecodeRawStr decodes some binary data from a human readable string encoded in raw mode
+// Digit counts no data length results in are rejected with ErrInvalidLength, non-zero bits in the last digit not
+// representing any data with ErrNonCanonical.
+func (enc *Encoding) DecodeRawStr(str string) ([]byte, error) {
+	data, err := enc.appendDecodeRaw(make([]byte, 0, len(str)*5/8), str)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+// IsRaw returns true if the string is a well-formatted raw mode string
+func (enc *Encoding) IsRaw(str string) bool {
+	_, err := enc.validateRaw(str)
+
+	return err == nil
+}
+
+// ValidateRaw returns nil if the string is a well-formatted raw mode string, otherwise the reason why it is not
+func (enc *Encoding) ValidateRaw(str string) error {
+	offset, err := enc.validateRaw(str)
+
+	return newValidationError(str, offset, err)
+}
+
+// EncodedRawLen returns the length of the raw mode encoding of n bytes of data
+func (enc *Encoding) EncodedRawLen(n int) int {
+	return enc.groupedLength((n*8 + 4) / 5)
+}
+
+// AppendEncodeRaw appends the raw mode encoding of src to dst and returns the extended buffer
+func (enc *Encoding) AppendEncodeRaw(dst, src []byte) []byte {
+	dst, result := grow(dst, enc.groupedLength((len(src)+4)/5*8))
+	enc.encode(src, result, 0)
+
+	// the digits only representing the zero fill of the last packet are cut off
+	return dst[:len(dst)-len(result)+enc.EncodedRawLen(len(src))]
+}
+
+// AppendDecodeRaw appends the data decoded from the raw mode src to dst and returns the extended buffer
+// On failure dst is returned unchanged.
+func (enc *Encoding) AppendDecodeRaw(dst, src []byte) ([]byte, error) {
+	return enc.appendDecodeRaw(dst, bytesToString(src))
+}
+
+// appendDecodeRaw appends the data decoded from the raw mode str to dst, dst is returned unchanged on failure
+func (enc *Encoding) appendDecodeRaw(dst []byte, str string) ([]byte, error) {
+	// separators are not needed, they only help readability
+	digitCount := len(str) - countByte(str, enc.separator)
+
+	partial := digitCount % packetDigits
+	trailingBits := rawTrailingBits[partial]
+	if trailingBits < 0 {
+		return dst, newCorruptInputError(str, len(str), ErrInvalidLength)
+	}
+
+	result, err := enc.decode(dst, str, 0, digitCount-partial)
+	if err != nil {
+		return dst, err
+	}
+
+	if partial == 0 {
+		return result, nil
+	}
+
+	// the digits of the last, partial packet are collected walking backward
+	var (
+		values [packetDigits]byte
+		last   int
+	)
+
+	for i, n := len(str)-1, partial; n > 0; i-- {
+		if str[i] == enc.separator {
+			continue
+		}
+
+		value, err := enc.getDigit(str[i])
+		if err != nil {
+			return dst, newCorruptInputError(str, i, err)
+		}
+
+		if n == partial {
+			last = i
+		}
+
+		n--
+		values[n] = value
+	}
+
+	if values[partial-1]&(1<<uint(trailingBits)-1) != 0 {
+		return dst, newCorruptInputError(str, last, ErrNonCanonical)
+	}
+
+	var packet [packetLength]byte
+	decodeValues(packet[:], &values)
+
+	return append(result, packet[:partial*5/8]...), nil
+}
+
+// validateRaw checks a well-formatted raw mode string in a single pass, returning the kind and offset of the first
+// problem found
+func (enc *Encoding) validateRaw(str string) (int, error) {
+	digitCount, offset, err := enc.scanGroups(str, 0)
+	if err != nil {
+		return offset, err
+	}
+
+	trailingBits := rawTrailingBits[digitCount%packetDigits]
+	if trailingBits < 0 {
+		return len(str), ErrInvalidLength
+	}
+
+	// scanGroups does not allow the string to end in a separator
+	if trailingBits > 0 && enc.decodeMap[str[len(str)-1]]&(1<<uint(trailingBits)-1) != 0 {
+		return len(str) - 1, ErrNonCanonical
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,247 @@
+package bfh
+
+import (
+	"crypto/rand"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_EncodeRawStr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Bytes          []byte
+			ExpectedResult string
+		}{
+			{
+				Name:           "empty",
+				Bytes:          []byte{},
+				ExpectedResult: "",
+			},
+			{
+				Name:           "1 byte",
+				Bytes:          []byte{255},
+				ExpectedResult: "zw",
+			},
+			{
+				Name:           "5 bytes",
+				Bytes:          []byte{255, 32, 167, 0, 253},
+				ExpectedResult: "zwga-e07x",
+			},
+			{
+				Name:           "6 bytes",
+				Bytes:          []byte{255, 32, 167, 0, 253, 17},
+				ExpectedResult: "zwga-e07x-24",
+			},
+			{
+				Name:           "16 bytes",
+				Bytes:          []byte{255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255},
+				ExpectedResult: "zzzz-zzzz-zzzz-zzzz-zzzz-zzzz-zw",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := EncodeRawStr(tt.Bytes)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+				assert.Len(t, actualResult, EncodedRawLen(len(tt.Bytes)))
+			})
+		}
+	})
+
+	t.Run("fail on nil", func(t *testing.T) {
+		_, err := EncodeRawStr(nil)
+
+		assert.ErrorIs(t, err, ErrNilInput)
+	})
+
+	t.Run("random success", func(t *testing.T) {
+		for length := 0; length <= 40; length++ {
+			b := make([]byte, length)
+
+			_, err := rand.Read(b)
+			require.NoError(t, err)
+
+			encoded, err := EncodeRawStr(b)
+			require.NoError(t, err)
+
+			assert.True(t, IsRaw(encoded), fmt.Sprintf("Failing value: %s", encoded))
+
+			decoded, err := DecodeRawStr(encoded)
+			require.NoError(t, err)
+
+			assert.Equal(t, b, decoded)
+		}
+	})
+}
+
+func Test_AppendEncodeRaw(t *testing.T) {
+	actualResult := AppendEncodeRaw([]byte("id:"), []byte{255, 32, 167, 0, 253, 17})
+
+	assert.Equal(t, "id:zwga-e07x-24", string(actualResult))
+}
+
+func Test_DecodeRawStr(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedResult []byte
+		}{
+			{
+				Name:           "empty",
+				String:         "",
+				ExpectedResult: []byte{},
+			},
+			{
+				Name:           "1 byte",
+				String:         "zw",
+				ExpectedResult: []byte{255},
+			},
+			{
+				Name:           "6 bytes",
+				String:         "zwga-e07x-24",
+				ExpectedResult: []byte{255, 32, 167, 0, 253, 17},
+			},
+			{
+				Name:           "misplaced dashes",
+				String:         "zw-gae07x2-4",
+				ExpectedResult: []byte{255, 32, 167, 0, 253, 17},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := DecodeRawStr(tt.String)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+
+				actualResult, err = DecodeRaw([]byte(tt.String))
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedErr    error
+			ExpectedOffset int
+		}{
+			{
+				Name:           "impossible length",
+				String:         "zwga-e07x-2",
+				ExpectedErr:    ErrInvalidLength,
+				ExpectedOffset: 11,
+			},
+			{
+				Name:           "non-zero trailing bits",
+				String:         "zwga-e07x-25",
+				ExpectedErr:    ErrNonCanonical,
+				ExpectedOffset: 11,
+			},
+			{
+				Name:           "invalid character in the last packet",
+				String:         "zwga-e07x-u4",
+				ExpectedErr:    ErrInvalidCharacter,
+				ExpectedOffset: 10,
+			},
+			{
+				Name:           "invalid character",
+				String:         "zwua-e07x-24",
+				ExpectedErr:    ErrInvalidCharacter,
+				ExpectedOffset: 2,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := DecodeRawStr(tt.String)
+
+				require.ErrorIs(t, err, tt.ExpectedErr, fmt.Sprintf("Failing value: %s", tt.String))
+
+				var corruptErr *CorruptInputError
+				require.ErrorAs(t, err, &corruptErr)
+				assert.Equal(t, tt.ExpectedOffset, corruptErr.Offset)
+			})
+		}
+	})
+}
+
+func Test_IsRaw(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			String string
+		}{
+			{
+				Name:   "empty",
+				String: "",
+			},
+			{
+				Name:   "1 byte",
+				String: "zw",
+			},
+			{
+				Name:   "strict",
+				String: "zwga-e07x",
+			},
+			{
+				Name:   "6 bytes",
+				String: "zwga-e07x-24",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				assert.True(t, IsRaw(tt.String), fmt.Sprintf("Failing value: %s", tt.String))
+				assert.NoError(t, ValidateRaw(tt.String))
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name        string
+			String      string
+			ExpectedErr error
+		}{
+			{
+				Name:        "impossible length",
+				String:      "zwg",
+				ExpectedErr: ErrInvalidLength,
+			},
+			{
+				Name:        "non-zero trailing bits",
+				String:      "zz",
+				ExpectedErr: ErrNonCanonical,
+			},
+			{
+				Name:        "misplaced dashes",
+				String:      "zw-gae07x2-4",
+				ExpectedErr: ErrInvalidCharacter,
+			},
+			{
+				Name:        "trailing dash",
+				String:      "zwga-",
+				ExpectedErr: ErrInvalidLength,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				assert.False(t, IsRaw(tt.String), fmt.Sprintf("Failing value: %s", tt.String))
+				assert.ErrorIs(t, ValidateRaw(tt.String), tt.ExpectedErr)
+			})
+		}
+	})
+}
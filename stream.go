@@ -0,0 +1,342 @@
+package bfh
+
+import (
+	"errors"
+	"io"
+)
+
+const (
+	errMsgEncoderClosed = "encoder is already closed"
+
+	// packetLength is the number of bytes encoded in one packet
+	packetLength = 5
+	// packetDigits is the number of characters one packet is encoded to
+	packetDigits = 8
+	// streamBufferSize is the size of the internal buffers used by the streaming encoder and decoder
+	streamBufferSize = 1024
+)
+
+// NewEncoder returns a new streaming encoder. Data written to the returned writer will be encoded and written to w.
+//
+// Since the length of the data is not known until the encoder is closed, the stream format differs from the one
+// generated by Encode: the padding digit is not written at the beginning of the string but after the last packet,
+// separated by a dash. (e.g. "mw6g-0000-3" instead of "3-mw6g-0000")
+//
+// Close must be called to flush any partially written packet and to write the padding digit.
+func NewEncoder(w io.Writer) io.WriteCloser {
+	return StdEncoding.NewEncoder(w)
+}
+
+// NewStrictEncoder returns a new streaming encoder using strict mode. Data written to the returned writer will be
+// encoded and written to w, the result being the same as if EncodeStrict was used.
+//
+// Close must be called after the last write. It will return an error if the total length of data written is not
+// dividable by 5.
+func NewStrictEncoder(w io.Writer) io.WriteCloser {
+	return StdEncoding.NewStrictEncoder(w)
+}
+
+// NewEncoder returns a new streaming encoder, see the package level NewEncoder for details of the stream format
+// If the encoding has no padding header, the returned encoder behaves like the one returned by NewStrictEncoder.
+func (enc *Encoding) NewEncoder(w io.Writer) io.WriteCloser {
+	return &encoder{enc: enc, w: w, strict: !enc.paddingHeader}
+}
+
+// NewStrictEncoder returns a new streaming encoder using strict mode
+func (enc *Encoding) NewStrictEncoder(w io.Writer) io.WriteCloser {
+	return &encoder{enc: enc, w: w, strict: true}
+}
+
+type encoder struct {
+	enc    *Encoding
+	w      io.Writer
+	strict bool
+	err    error
+	buf    [packetLength]byte
+	nbuf   int
+	chars  int
+	out    [streamBufferSize]byte
+	nout   int
+}
+
+// Write encodes all complete packets in p and buffers the rest until the next Write or Close
+func (e *encoder) Write(p []byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+
+	n := len(p)
+
+	// fill up the previously buffered partial packet first
+	if e.nbuf > 0 {
+		i := copy(e.buf[e.nbuf:], p)
+		e.nbuf += i
+		p = p[i:]
+
+		if e.nbuf < packetLength {
+			return n, nil
+		}
+
+		e.writePacket(e.buf[:])
+		e.nbuf = 0
+	}
+
+	for len(p) >= packetLength && e.err == nil {
+		e.writePacket(p[:packetLength])
+		p = p[packetLength:]
+	}
+
+	if e.err != nil {
+		return n - len(p), e.err
+	}
+
+	e.nbuf = copy(e.buf[:], p)
+
+	return n, nil
+}
+
+// Close flushes any pending output and, unless in strict mode, writes the padding digit
+// It does not close the underlying writer. Any Write or Close following a successful Close returns an error.
+func (e *encoder) Close() error {
+	if e.err != nil {
+		return e.err
+	}
+
+	var padding int
+	if e.nbuf > 0 {
+		if e.strict {
+			e.err = strictLengthError()
+
+			return e.err
+		}
+
+		padding = packetLength - e.nbuf
+		for i := e.nbuf; i < packetLength; i++ {
+			e.buf[i] = 0
+		}
+
+		e.writePacket(e.buf[:])
+		e.nbuf = 0
+	}
+
+	if !e.strict {
+		if e.chars > 0 && e.enc.groupLength > 0 {
+			e.writeByte(e.enc.separator)
+		}
+		e.writeByte(e.enc.alphabet[padding])
+	}
+
+	e.flush()
+	if e.err != nil {
+		return e.err
+	}
+
+	// anything written after the padding digit would corrupt the output
+	e.err = errors.New(errMsgEncoderClosed)
+
+	return nil
+}
+
+// writePacket encodes a packet of 5 bytes into the output buffer
+func (e *encoder) writePacket(packet []byte) {
+	word := packetWord(packet)
+	for i := 0; i < packetDigits; i++ {
+		e.writeDigit(e.enc.alphabet[word>>uint(35-5*i)&0x1f])
+	}
+}
+
+// writeDigit writes a single character into the output buffer, preceded by a separator if a new group starts
+func (e *encoder) writeDigit(ch byte) {
+	if e.chars > 0 && e.enc.groupLength > 0 && e.chars%e.enc.groupLength == 0 {
+		e.writeByte(e.enc.separator)
+	}
+
+	e.writeByte(ch)
+	e.chars++
+}
+
+func (e *encoder) writeByte(b byte) {
+	if e.nout == len(e.out) {
+		e.flush()
+	}
+
+	e.out[e.nout] = b
+	e.nout++
+}
+
+func (e *encoder) flush() {
+	if e.err != nil || e.nout == 0 {
+		return
+	}
+
+	_, e.err = e.w.Write(e.out[:e.nout])
+	e.nout = 0
+}
+
+// NewDecoder returns a new streaming decoder reading the format written by NewEncoder from r
+// Dashes are ignored, the padding digit is expected to be the last character of the stream.
+func NewDecoder(r io.Reader) io.Reader {
+	return StdEncoding.NewDecoder(r)
+}
+
+// NewStrictDecoder returns a new streaming decoder reading strictly encoded data from r
+// Dashes are ignored, the number of remaining characters must be dividable by 8.
+func NewStrictDecoder(r io.Reader) io.Reader {
+	return StdEncoding.NewStrictDecoder(r)
+}
+
+// NewDecoder returns a new streaming decoder reading the format written by NewEncoder from r
+// If the encoding has no padding header, the returned decoder behaves like the one returned by NewStrictDecoder.
+func (enc *Encoding) NewDecoder(r io.Reader) io.Reader {
+	return &decoder{enc: enc, r: r, strict: !enc.paddingHeader}
+}
+
+// NewStrictDecoder returns a new streaming decoder reading strictly encoded data from r
+func (enc *Encoding) NewStrictDecoder(r io.Reader) io.Reader {
+	return &decoder{enc: enc, r: r, strict: true}
+}
+
+type decoder struct {
+	enc    *Encoding
+	r      io.Reader
+	strict bool
+	err    error
+	in     [streamBufferSize]byte
+	digits [packetDigits]byte
+	ndigit int
+	// offset is the number of bytes read from r, used for error reporting
+	offset int
+	// lastOffset is the offset of the last digit read
+	lastOffset int
+	// in normal mode the last decoded packet is held back until it is known not to be the last one
+	held    [packetLength]byte
+	hasHeld bool
+	outbuf  [streamBufferSize/packetDigits*packetLength + packetLength]byte
+	out     []byte
+}
+
+// Read decodes data from the underlying reader into p
+func (d *decoder) Read(p []byte) (int, error) {
+	for len(d.out) == 0 {
+		if d.err != nil {
+			return 0, d.err
+		}
+
+		d.fill()
+	}
+
+	n := copy(p, d.out)
+	d.out = d.out[n:]
+
+	return n, nil
+}
+
+// fill reads the next chunk of the encoded stream and decodes every complete packet in it
+func (d *decoder) fill() {
+	nr, err := d.r.Read(d.in[:])
+
+	out := d.outbuf[:0]
+	for i, ch := range d.in[:nr] {
+		if ch == d.enc.separator {
+			continue
+		}
+
+		value, digitErr := d.enc.getDigit(ch)
+		if digitErr != nil {
+			d.err = &CorruptInputError{Offset: d.offset + i, Rune: rune(ch), Err: digitErr}
+
+			break
+		}
+
+		d.lastOffset = d.offset + i
+		d.digits[d.ndigit] = value
+		d.ndigit++
+
+		if d.ndigit < len(d.digits) {
+			continue
+		}
+
+		d.ndigit = 0
+		out = d.decodePacket(out)
+	}
+
+	d.offset += nr
+
+	if d.err == nil && err != nil {
+		d.err = err
+		if err == io.EOF {
+			out = d.finish(out)
+		}
+	}
+
+	d.out = out
+}
+
+// decodePacket decodes the 8 buffered digits and appends the result (or the previously held back packet) to out
+func (d *decoder) decodePacket(out []byte) []byte {
+	var packet [packetLength]byte
+	decodeValues(packet[:], &d.digits)
+
+	if d.strict {
+		return append(out, packet[:]...)
+	}
+
+	if d.hasHeld {
+		out = append(out, d.held[:]...)
+	}
+
+	d.held = packet
+	d.hasHeld = true
+
+	return out
+}
+
+// finish processes the end of the stream, checking the remaining digits and removing the padding if necessary
+func (d *decoder) finish(out []byte) []byte {
+	if d.strict {
+		if d.ndigit != 0 {
+			d.err = &CorruptInputError{Offset: d.offset, Err: ErrInvalidLength}
+		}
+
+		return out
+	}
+
+	// the padding digit must be the only digit after the last packet
+	if d.ndigit != 1 {
+		d.err = &CorruptInputError{Offset: d.offset, Err: ErrInvalidLength}
+
+		return out
+	}
+
+	padding := int(d.digits[0])
+	if padding > 4 || (padding > 0 && !d.hasHeld) {
+		d.err = &CorruptInputError{Offset: d.lastOffset, Rune: rune(d.enc.alphabet[padding]), Err: ErrInvalidPadding}
+
+		return out
+	}
+
+	if !d.hasHeld {
+		return out
+	}
+
+	d.hasHeld = false
+
+	if d.enc.canonical && !isZero(d.held[packetLength-padding:]) {
+		d.err = &CorruptInputError{Offset: d.lastOffset, Rune: rune(d.enc.alphabet[padding]), Err: ErrNonCanonical}
+
+		return out
+	}
+
+	return append(out, d.held[:packetLength-padding]...)
+}
+
+// isZero returns true if all bytes of b are zeros
+func isZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,323 @@
+package bfh
+
+import (
+	"bytes"
+	"crypto/rand"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_NewEncoder(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Bytes          []byte
+			ExpectedResult string
+		}{
+			{
+				Name:           "empty",
+				Bytes:          []byte{},
+				ExpectedResult: "0",
+			},
+			{
+				Name:           "0x7e",
+				Bytes:          []byte{126},
+				ExpectedResult: "fr00-0000-4",
+			},
+			{
+				Name:           "0xff without padding",
+				Bytes:          []byte{255, 0, 0, 0, 0},
+				ExpectedResult: "zw00-0000-0",
+			},
+			{
+				Name:           "6 bytes long 0xff",
+				Bytes:          []byte{255, 255, 255, 255, 255, 255},
+				ExpectedResult: "zzzz-zzzz-zw00-0000-4",
+			},
+			{
+				Name:           "4 bytes long 0xff",
+				Bytes:          []byte{255, 255, 255, 255},
+				ExpectedResult: "zzzz-zzr0-1",
+			},
+			{
+				Name:           "somewhat random numbers",
+				Bytes:          []byte{255, 32, 167, 0, 253, 17},
+				ExpectedResult: "zwga-e07x-2400-0000-4",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				buf := &bytes.Buffer{}
+				w := NewEncoder(buf)
+
+				for i := range tt.Bytes {
+					_, err := w.Write(tt.Bytes[i : i+1])
+					require.NoError(t, err)
+				}
+
+				err := w.Close()
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, buf.String())
+			})
+		}
+	})
+
+	t.Run("matches Encode apart from the padding digit", func(t *testing.T) {
+		for _, length := range []int{1, 37, 69, 120, 141, 1000, 4099} {
+			t.Run(fmt.Sprintf("%d", length), func(t *testing.T) {
+				b := make([]byte, length)
+
+				_, err := rand.Read(b)
+				require.NoError(t, err)
+
+				expected, err := EncodeStr(b)
+				require.NoError(t, err)
+
+				buf := &bytes.Buffer{}
+				w := NewEncoder(buf)
+				_, err = w.Write(b)
+				require.NoError(t, err)
+				require.NoError(t, w.Close())
+
+				assert.Equal(t, expected[2:]+"-"+expected[:1], buf.String())
+			})
+		}
+	})
+
+	t.Run("fail after close", func(t *testing.T) {
+		for _, strict := range []bool{false, true} {
+			t.Run(fmt.Sprintf("strict %t", strict), func(t *testing.T) {
+				buf := &bytes.Buffer{}
+				w := NewEncoder(buf)
+				if strict {
+					w = NewStrictEncoder(buf)
+				}
+
+				_, err := w.Write([]byte{1, 2, 3, 4, 5})
+				require.NoError(t, err)
+				require.NoError(t, w.Close())
+
+				expected := buf.String()
+
+				n, err := w.Write([]byte{1})
+				assert.Error(t, err)
+				assert.Equal(t, 0, n)
+
+				assert.Error(t, w.Close())
+				assert.Equal(t, expected, buf.String())
+			})
+		}
+	})
+}
+
+func Test_NewStrictEncoder(t *testing.T) {
+	t.Run("random success", func(t *testing.T) {
+		for _, length := range []int{0, 5, 40, 1000, 4100} {
+			t.Run(fmt.Sprintf("%d", length), func(t *testing.T) {
+				b := make([]byte, length)
+
+				_, err := rand.Read(b)
+				require.NoError(t, err)
+
+				expected, err := EncodeStrictStr(b)
+				require.NoError(t, err)
+
+				buf := &bytes.Buffer{}
+				w := NewStrictEncoder(buf)
+				for i := 0; i < len(b); i += 3 {
+					end := i + 3
+					if end > len(b) {
+						end = len(b)
+					}
+
+					_, err = w.Write(b[i:end])
+					require.NoError(t, err)
+				}
+				require.NoError(t, w.Close())
+
+				assert.Equal(t, expected, buf.String())
+			})
+		}
+	})
+
+	t.Run("fail on wrong length", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		w := NewStrictEncoder(buf)
+
+		_, err := w.Write(make([]byte, 14))
+		require.NoError(t, err)
+
+		err = w.Close()
+
+		assert.Error(t, err)
+	})
+}
+
+func Test_NewDecoder(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedResult []byte
+		}{
+			{
+				Name:           "empty",
+				String:         "0",
+				ExpectedResult: []byte{},
+			},
+			{
+				Name:           "0x7e",
+				String:         "fr00-0000-4",
+				ExpectedResult: []byte{126},
+			},
+			{
+				Name:           "6 bytes long 0xff",
+				String:         "zzzz-zzzz-zw00-0000-4",
+				ExpectedResult: []byte{255, 255, 255, 255, 255, 255},
+			},
+			{
+				Name:           "without dashes",
+				String:         "zzzzzzzzzw0000004",
+				ExpectedResult: []byte{255, 255, 255, 255, 255, 255},
+			},
+			{
+				Name:           "4 bytes long 0xff",
+				String:         "zzzz-zzr0-1",
+				ExpectedResult: []byte{255, 255, 255, 255},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				r := NewDecoder(iotest.OneByteReader(strings.NewReader(tt.String)))
+
+				actualResult, err := ioutil.ReadAll(r)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tests := []struct {
+			Name        string
+			String      string
+			ExpectedErr error
+		}{
+			{
+				Name:        "empty",
+				String:      "",
+				ExpectedErr: ErrInvalidLength,
+			},
+			{
+				Name:        "missing padding digit",
+				String:      "zzzz-zzzz",
+				ExpectedErr: ErrInvalidLength,
+			},
+			{
+				Name:        "invalid padding",
+				String:      "zzzz-zzzz-7",
+				ExpectedErr: ErrInvalidPadding,
+			},
+			{
+				Name:        "padding without data",
+				String:      "3",
+				ExpectedErr: ErrInvalidPadding,
+			},
+			{
+				Name:        "invalid character",
+				String:      "ouga-e07x-2400-0000-4",
+				ExpectedErr: ErrInvalidCharacter,
+			},
+			{
+				Name:        "wrong length",
+				String:      "zwga-e0-4",
+				ExpectedErr: ErrInvalidLength,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := ioutil.ReadAll(NewDecoder(strings.NewReader(tt.String)))
+
+				assert.ErrorIs(t, err, tt.ExpectedErr, fmt.Sprintf("Failing value: %s", tt.String))
+			})
+		}
+	})
+
+	t.Run("random success", func(t *testing.T) {
+		for _, length := range []int{0, 1, 37, 69, 120, 141, 1000, 4099} {
+			t.Run(fmt.Sprintf("%d", length), func(t *testing.T) {
+				b := make([]byte, length)
+
+				_, err := rand.Read(b)
+				require.NoError(t, err)
+
+				buf := &bytes.Buffer{}
+				w := NewEncoder(buf)
+				_, err = w.Write(b)
+				require.NoError(t, err)
+				require.NoError(t, w.Close())
+
+				decoded, err := ioutil.ReadAll(NewDecoder(buf))
+				require.NoError(t, err)
+
+				assert.Equal(t, b, decoded)
+			})
+		}
+	})
+}
+
+func Test_NewStrictDecoder(t *testing.T) {
+	t.Run("random success", func(t *testing.T) {
+		for _, length := range []int{0, 5, 40, 1000, 4100} {
+			t.Run(fmt.Sprintf("%d", length), func(t *testing.T) {
+				b := make([]byte, length)
+
+				_, err := rand.Read(b)
+				require.NoError(t, err)
+
+				encoded, err := EncodeStrictStr(b)
+				require.NoError(t, err)
+
+				decoded, err := ioutil.ReadAll(NewStrictDecoder(iotest.HalfReader(strings.NewReader(encoded))))
+				require.NoError(t, err)
+
+				assert.Equal(t, b, decoded)
+			})
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			String string
+		}{
+			{
+				Name:   "wrong length",
+				String: "zwga-e07x-24",
+			},
+			{
+				Name:   "invalid character",
+				String: "ouga-e07x",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := ioutil.ReadAll(NewStrictDecoder(strings.NewReader(tt.String)))
+
+				assert.Error(t, err, fmt.Sprintf("Failing value: %s", tt.String))
+			})
+		}
+	})
+}
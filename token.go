@@ -0,0 +1,109 @@
+package bfh
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+)
+
+const errMsgTokenLengthNotPositive = "token length must be positive"
+
+// DefaultTokenGenerator is used by NewToken and its siblings, reading from crypto/rand and encoding with StdEncoding
+var DefaultTokenGenerator = &TokenGenerator{}
+
+// TokenGenerator generates random tokens, the output always passes the IsWellFormatted or IsStrict check of its encoding
+type TokenGenerator struct {
+	// Rand is the source of randomness, crypto/rand.Reader if nil
+	// It can be replaced to make tests deterministic.
+	Rand io.Reader
+	// Encoding is used to encode the random bytes, StdEncoding if nil
+	Encoding *Encoding
+}
+
+// NewToken returns a normal mode encoded token of nBytes random bytes
+func NewToken(nBytes int) (string, error) {
+	return DefaultTokenGenerator.NewToken(nBytes)
+}
+
+// NewStrictToken returns a strict mode encoded token of at least nBytes random bytes, rounded up to some multiple of 5
+func NewStrictToken(nBytes int) (string, error) {
+	return DefaultTokenGenerator.NewStrictToken(nBytes)
+}
+
+// NewTokenBits returns a normal mode encoded token of at least bits bits of entropy, rounded up to whole bytes
+func NewTokenBits(bits int) (string, error) {
+	return DefaultTokenGenerator.NewTokenBits(bits)
+}
+
+// NewStrictTokenBits returns a strict mode encoded token of at least bits bits of entropy, rounded up to some multiple
+// of 40 bits
+func NewStrictTokenBits(bits int) (string, error) {
+	return DefaultTokenGenerator.NewStrictTokenBits(bits)
+}
+
+// NewToken returns a normal mode encoded token of nBytes random bytes
+func (g *TokenGenerator) NewToken(nBytes int) (string, error) {
+	data, err := g.read(nBytes)
+	if err != nil {
+		return "", err
+	}
+
+	return g.encoding().EncodeStr(data)
+}
+
+// NewStrictToken returns a strict mode encoded token of at least nBytes random bytes, rounded up to some multiple of 5
+func (g *TokenGenerator) NewStrictToken(nBytes int) (string, error) {
+	data, err := g.read(roundUp(nBytes, 5))
+	if err != nil {
+		return "", err
+	}
+
+	return g.encoding().EncodeStrictStr(data)
+}
+
+// NewTokenBits returns a normal mode encoded token of at least bits bits of entropy, rounded up to whole bytes
+func (g *TokenGenerator) NewTokenBits(bits int) (string, error) {
+	return g.NewToken(roundUp(bits, 8) / 8)
+}
+
+// NewStrictTokenBits returns a strict mode encoded token of at least bits bits of entropy, rounded up to some multiple
+// of 40 bits
+func (g *TokenGenerator) NewStrictTokenBits(bits int) (string, error) {
+	return g.NewStrictToken(roundUp(bits, 8) / 8)
+}
+
+// read returns nBytes bytes read from the source of randomness
+func (g *TokenGenerator) read(nBytes int) ([]byte, error) {
+	if nBytes <= 0 {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidLength, errMsgTokenLengthNotPositive)
+	}
+
+	r := g.Rand
+	if r == nil {
+		r = rand.Reader
+	}
+
+	data := make([]byte, nBytes)
+	if _, err := io.ReadFull(r, data); err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
+
+func (g *TokenGenerator) encoding() *Encoding {
+	if g.Encoding == nil {
+		return StdEncoding
+	}
+
+	return g.Encoding
+}
+
+// roundUp returns the smallest multiple of m not less than n, n itself if it is not positive
+func roundUp(n, m int) int {
+	if n <= 0 {
+		return n
+	}
+
+	return (n + m - 1) / m * m
+}
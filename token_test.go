@@ -0,0 +1,158 @@
+package bfh
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_TokenGenerator(t *testing.T) {
+	random := []byte{255, 32, 167, 0, 253, 17, 2, 3, 4, 5}
+
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			Generate       func(g *TokenGenerator) (string, error)
+			ExpectedResult string
+		}{
+			{
+				Name:           "bytes",
+				Generate:       func(g *TokenGenerator) (string, error) { return g.NewToken(6) },
+				ExpectedResult: "4-zwga-e07x-2400-0000",
+			},
+			{
+				Name:           "strict bytes",
+				Generate:       func(g *TokenGenerator) (string, error) { return g.NewStrictToken(5) },
+				ExpectedResult: "zwga-e07x",
+			},
+			{
+				Name:           "strict bytes rounded up",
+				Generate:       func(g *TokenGenerator) (string, error) { return g.NewStrictToken(6) },
+				ExpectedResult: "zwga-e07x-2410-6105",
+			},
+			{
+				Name:           "bits rounded up",
+				Generate:       func(g *TokenGenerator) (string, error) { return g.NewTokenBits(41) },
+				ExpectedResult: "4-zwga-e07x-2400-0000",
+			},
+			{
+				Name:           "strict bits",
+				Generate:       func(g *TokenGenerator) (string, error) { return g.NewStrictTokenBits(40) },
+				ExpectedResult: "zwga-e07x",
+			},
+			{
+				Name:           "strict bits rounded up",
+				Generate:       func(g *TokenGenerator) (string, error) { return g.NewStrictTokenBits(41) },
+				ExpectedResult: "zwga-e07x-2410-6105",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				g := &TokenGenerator{Rand: bytes.NewReader(random)}
+
+				actualResult, err := tt.Generate(g)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name          string
+			Generate      func(g *TokenGenerator) (string, error)
+			ExpectedError error
+		}{
+			{
+				Name:          "zero bytes",
+				Generate:      func(g *TokenGenerator) (string, error) { return g.NewToken(0) },
+				ExpectedError: ErrInvalidLength,
+			},
+			{
+				Name:          "negative strict bytes",
+				Generate:      func(g *TokenGenerator) (string, error) { return g.NewStrictToken(-5) },
+				ExpectedError: ErrInvalidLength,
+			},
+			{
+				Name:          "zero bits",
+				Generate:      func(g *TokenGenerator) (string, error) { return g.NewTokenBits(0) },
+				ExpectedError: ErrInvalidLength,
+			},
+			{
+				Name:          "not enough randomness",
+				Generate:      func(g *TokenGenerator) (string, error) { return g.NewToken(11) },
+				ExpectedError: io.ErrUnexpectedEOF,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				g := &TokenGenerator{Rand: bytes.NewReader(random)}
+
+				_, err := tt.Generate(g)
+
+				assert.ErrorIs(t, err, tt.ExpectedError)
+			})
+		}
+	})
+
+	t.Run("custom encoding", func(t *testing.T) {
+		g := &TokenGenerator{
+			Rand:     bytes.NewReader(random),
+			Encoding: MustNewEncoding("0123456789ABCDEFGHJKMNPQRSTVWXYZ"),
+		}
+
+		actualResult, err := g.NewStrictToken(5)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "ZWGA-E07X", actualResult)
+	})
+}
+
+func Test_NewToken(t *testing.T) {
+	for nBytes := 1; nBytes <= 32; nBytes++ {
+		token, err := NewToken(nBytes)
+		require.NoError(t, err)
+
+		assert.True(t, IsWellFormatted(token), fmt.Sprintf("Failing value: %s", token))
+
+		data, err := DecodeStr(token)
+		require.NoError(t, err)
+		assert.Len(t, data, nBytes)
+	}
+}
+
+func Test_NewStrictToken(t *testing.T) {
+	for nBytes := 1; nBytes <= 32; nBytes++ {
+		token, err := NewStrictToken(nBytes)
+		require.NoError(t, err)
+
+		assert.True(t, IsStrict(token), fmt.Sprintf("Failing value: %s", token))
+
+		data, err := DecodeStrictStr(token)
+		require.NoError(t, err)
+		assert.Len(t, data, roundUp(nBytes, 5))
+	}
+}
+
+func Test_NewTokenBits(t *testing.T) {
+	token, err := NewTokenBits(128)
+	require.NoError(t, err)
+
+	data, err := DecodeStr(token)
+	require.NoError(t, err)
+	assert.Len(t, data, 16)
+
+	token, err = NewStrictTokenBits(128)
+	require.NoError(t, err)
+
+	data, err = DecodeStrictStr(token)
+	require.NoError(t, err)
+	assert.Len(t, data, 20)
+}
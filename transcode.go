@@ -0,0 +1,207 @@
+package bfh
+
+import (
+	"encoding/base32"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const errMsgUnknownScheme = "unknown scheme"
+
+// Scheme is a text representation of binary data Transcode can read and write
+type Scheme string
+
+const (
+	// SchemeHex is hexadecimal text, written in lowercase
+	SchemeHex Scheme = "hex"
+	// SchemeBase64 is the standard base64 encoding of RFC 4648, written with padding
+	SchemeBase64 Scheme = "base64"
+	// SchemeBase64URL is the URL-safe base64 encoding of RFC 4648, written without padding as used by JWTs
+	SchemeBase64URL Scheme = "base64url"
+	// SchemeBase32 is the standard base32 encoding of RFC 4648, written with padding as used by TOTP secrets
+	SchemeBase32 Scheme = "base32"
+	// SchemeBase32Hex is the base32 encoding of RFC 4648 with the extended hex alphabet, written with padding
+	SchemeBase32Hex Scheme = "base32hex"
+	// SchemeBFH is bfh in normal mode
+	SchemeBFH Scheme = "bfh"
+	// SchemeBFHStrict is bfh in strict mode
+	SchemeBFHStrict Scheme = "bfh-strict"
+)
+
+// Schemes lists all schemes, ordered from the smallest alphabet to the largest, the order Sniff breaks ties in
+var Schemes = []Scheme{SchemeHex, SchemeBFHStrict, SchemeBFH, SchemeBase32, SchemeBase32Hex, SchemeBase64URL, SchemeBase64}
+
+// Transcode decodes str from one scheme and encodes the data into another
+// Padding is optional when decoding base64 and base32, base32 is also accepted in lowercase, but not in mixed case.
+func Transcode(str string, from, to Scheme) (string, error) {
+	data, err := DecodeScheme(str, from)
+	if err != nil {
+		return "", err
+	}
+
+	return EncodeScheme(data, to)
+}
+
+// DecodeScheme decodes str written in the scheme given
+func DecodeScheme(str string, from Scheme) ([]byte, error) {
+	switch from {
+	case SchemeHex:
+		return hex.DecodeString(str)
+	case SchemeBase64:
+		return decodeBase64(base64.StdEncoding, str)
+	case SchemeBase64URL:
+		return decodeBase64(base64.URLEncoding, str)
+	case SchemeBase32:
+		return decodeBase32(base32.StdEncoding, str)
+	case SchemeBase32Hex:
+		return decodeBase32(base32.HexEncoding, str)
+	case SchemeBFH:
+		return DecodeStr(str)
+	case SchemeBFHStrict:
+		return DecodeStrictStr(str)
+	}
+
+	return nil, fmt.Errorf("%s: %q", errMsgUnknownScheme, from)
+}
+
+// EncodeScheme encodes binary data in the scheme given
+func EncodeScheme(b []byte, to Scheme) (string, error) {
+	if b == nil {
+		return "", ErrNilInput
+	}
+
+	switch to {
+	case SchemeHex:
+		return hex.EncodeToString(b), nil
+	case SchemeBase64:
+		return base64.StdEncoding.EncodeToString(b), nil
+	case SchemeBase64URL:
+		return base64.RawURLEncoding.EncodeToString(b), nil
+	case SchemeBase32:
+		return base32.StdEncoding.EncodeToString(b), nil
+	case SchemeBase32Hex:
+		return base32.HexEncoding.EncodeToString(b), nil
+	case SchemeBFH:
+		return EncodeStr(b)
+	case SchemeBFHStrict:
+		return EncodeStrictStr(b)
+	}
+
+	return "", fmt.Errorf("%s: %q", errMsgUnknownScheme, to)
+}
+
+// Sniff returns the schemes str can be decoded from, the most likely first
+// Schemes are ranked by how characteristic str is for them, e.g. properly placed dashes for bfh, + or / and mixed case
+// for base64 and = padding for base32. Ties are broken by the order of Schemes.
+func Sniff(str string) []Scheme {
+	type candidate struct {
+		scheme Scheme
+		score  int
+	}
+
+	var candidates []candidate
+	for _, scheme := range Schemes {
+		if _, err := DecodeScheme(str, scheme); err != nil {
+			continue
+		}
+
+		candidates = append(candidates, candidate{scheme: scheme, score: sniffScore(str, scheme)})
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score > candidates[j].score
+	})
+
+	result := make([]Scheme, len(candidates))
+	for i, c := range candidates {
+		result[i] = c.scheme
+	}
+
+	return result
+}
+
+// sniffScore tells how characteristic str is for a scheme it can be decoded from, from 1 to 3
+func sniffScore(str string, scheme Scheme) int {
+	switch scheme {
+	case SchemeHex:
+		return 2
+	case SchemeBFH:
+		if IsWellFormatted(str) {
+			return 3
+		}
+	case SchemeBFHStrict:
+		if IsStrict(str) && strings.IndexByte(str, separator) >= 0 {
+			return 3
+		}
+	case SchemeBase32, SchemeBase32Hex:
+		if strings.HasSuffix(str, "=") || (len(str)%8 == 0 && str == strings.ToUpper(str)) {
+			return 2
+		}
+	case SchemeBase64:
+		if strings.ContainsAny(str, "+/") {
+			return 3
+		}
+
+		// unlike hex and base32, base64 mixes uppercase and lowercase letters
+		if strings.HasSuffix(str, "=") || mixedCaseOffset(str) >= 0 {
+			return 2
+		}
+	case SchemeBase64URL:
+		if strings.ContainsAny(str, "-_") {
+			return 2
+		}
+	}
+
+	return 1
+}
+
+// decodeBase64 decodes base64 with or without padding
+func decodeBase64(enc *base64.Encoding, str string) ([]byte, error) {
+	if !strings.HasSuffix(str, "=") {
+		return enc.WithPadding(base64.NoPadding).DecodeString(str)
+	}
+
+	return enc.DecodeString(str)
+}
+
+// decodeBase32 decodes base32 with or without padding, in uppercase or lowercase
+// Base32 is a single-case encoding, therefore strings mixing the two are rejected.
+func decodeBase32(enc *base32.Encoding, str string) ([]byte, error) {
+	if offset := mixedCaseOffset(str); offset >= 0 {
+		return nil, base32.CorruptInputError(offset)
+	}
+
+	str = strings.ToUpper(str)
+
+	if !strings.HasSuffix(str, "=") {
+		return enc.WithPadding(base32.NoPadding).DecodeString(str)
+	}
+
+	return enc.DecodeString(str)
+}
+
+// mixedCaseOffset returns the offset of the first ASCII letter not in the case of the letters preceding it, -1 if all
+// letters of str are in the same case
+func mixedCaseOffset(str string) int {
+	var upper, lower bool
+
+	for i := 0; i < len(str); i++ {
+		switch c := str[i]; {
+		case c >= 'A' && c <= 'Z':
+			upper = true
+		case c >= 'a' && c <= 'z':
+			lower = true
+		default:
+			continue
+		}
+
+		if upper && lower {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -0,0 +1,231 @@
+package bfh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Transcode(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			From           Scheme
+			To             Scheme
+			ExpectedResult string
+		}{
+			{
+				Name:           "hex to bfh",
+				String:         "ff20a700fd11",
+				From:           SchemeHex,
+				To:             SchemeBFH,
+				ExpectedResult: "4-zwga-e07x-2400-0000",
+			},
+			{
+				Name:           "uppercase hex to bfh strict",
+				String:         "FF20A700FD",
+				From:           SchemeHex,
+				To:             SchemeBFHStrict,
+				ExpectedResult: "zwga-e07x",
+			},
+			{
+				Name:           "bfh to hex",
+				String:         "4-zwga-e07x-2400-0000",
+				From:           SchemeBFH,
+				To:             SchemeHex,
+				ExpectedResult: "ff20a700fd11",
+			},
+			{
+				Name:           "bfh strict to base64",
+				String:         "zwga-e07x",
+				From:           SchemeBFHStrict,
+				To:             SchemeBase64,
+				ExpectedResult: "/yCnAP0=",
+			},
+			{
+				Name:           "base64 without padding to bfh",
+				String:         "/yCnAP0",
+				From:           SchemeBase64,
+				To:             SchemeBFHStrict,
+				ExpectedResult: "zwga-e07x",
+			},
+			{
+				Name:           "bfh to base64url",
+				String:         "zwga-e07x",
+				From:           SchemeBFHStrict,
+				To:             SchemeBase64URL,
+				ExpectedResult: "_yCnAP0",
+			},
+			{
+				Name:           "base64url with padding to bfh",
+				String:         "_yCnAP0=",
+				From:           SchemeBase64URL,
+				To:             SchemeBFHStrict,
+				ExpectedResult: "zwga-e07x",
+			},
+			{
+				Name:           "bfh to base32",
+				String:         "4-zwga-e07x-2400-0000",
+				From:           SchemeBFH,
+				To:             SchemeBase32,
+				ExpectedResult: "74QKOAH5CE======",
+			},
+			{
+				Name:           "lowercase base32 without padding to bfh",
+				String:         "74qkoah5ce",
+				From:           SchemeBase32,
+				To:             SchemeBFH,
+				ExpectedResult: "4-zwga-e07x-2400-0000",
+			},
+			{
+				Name:           "base32hex to bfh",
+				String:         "VSGAE07T24======",
+				From:           SchemeBase32Hex,
+				To:             SchemeBFH,
+				ExpectedResult: "4-zwga-e07x-2400-0000",
+			},
+			{
+				Name:           "empty",
+				String:         "",
+				From:           SchemeHex,
+				To:             SchemeBFH,
+				ExpectedResult: "0-",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				actualResult, err := Transcode(tt.String, tt.From, tt.To)
+
+				assert.NoError(t, err)
+				assert.Equal(t, tt.ExpectedResult, actualResult)
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			String string
+			From   Scheme
+			To     Scheme
+		}{
+			{
+				Name:   "invalid hex",
+				String: "ff20a700fd1",
+				From:   SchemeHex,
+				To:     SchemeBFH,
+			},
+			{
+				Name:   "invalid bfh",
+				String: "4-zwga-e07u-2400-0000",
+				From:   SchemeBFH,
+				To:     SchemeHex,
+			},
+			{
+				Name:   "invalid length for strict",
+				String: "ff20a700fd11",
+				From:   SchemeHex,
+				To:     SchemeBFHStrict,
+			},
+			{
+				Name:   "mixed case base32",
+				String: "74qKoah5",
+				From:   SchemeBase32,
+				To:     SchemeBFH,
+			},
+			{
+				Name:   "unknown source",
+				String: "ff",
+				From:   "base58",
+				To:     SchemeBFH,
+			},
+			{
+				Name:   "unknown target",
+				String: "ff",
+				From:   SchemeHex,
+				To:     "base58",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				_, err := Transcode(tt.String, tt.From, tt.To)
+
+				assert.Error(t, err, fmt.Sprintf("Failing value: %s", tt.String))
+			})
+		}
+	})
+}
+
+func Test_Sniff(t *testing.T) {
+	tests := []struct {
+		Name           string
+		String         string
+		ExpectedResult []Scheme
+	}{
+		{
+			Name:           "bfh",
+			String:         "4-zwga-e07x-2400-0000",
+			ExpectedResult: []Scheme{SchemeBFH},
+		},
+		{
+			Name:           "bfh strict",
+			String:         "zwga-e07x",
+			ExpectedResult: []Scheme{SchemeBFHStrict},
+		},
+		{
+			Name:           "bfh strict without separators",
+			String:         "zwgae07x",
+			ExpectedResult: []Scheme{SchemeBFHStrict, SchemeBase64URL, SchemeBase64},
+		},
+		{
+			Name:           "hex",
+			String:         "ff20a700fd11",
+			ExpectedResult: []Scheme{SchemeHex, SchemeBase32Hex, SchemeBase64URL, SchemeBase64},
+		},
+		{
+			Name:           "base64",
+			String:         "/yCnAP0R",
+			ExpectedResult: []Scheme{SchemeBase64},
+		},
+		{
+			Name:           "base64url",
+			String:         "_yCnAP0R",
+			ExpectedResult: []Scheme{SchemeBase64URL},
+		},
+		{
+			Name:           "base32",
+			String:         "JBSWY3DPEHPK3PXP",
+			ExpectedResult: []Scheme{SchemeBase32, SchemeBase64URL, SchemeBase64},
+		},
+		{
+			Name:           "base32 with padding",
+			String:         "74QKOAH5CE======",
+			ExpectedResult: []Scheme{SchemeBase32, SchemeBase32Hex},
+		},
+		{
+			Name:           "base64 with padding",
+			String:         "aGVsbG8=",
+			ExpectedResult: []Scheme{SchemeBase64, SchemeBase64URL},
+		},
+		{
+			Name:           "mixed case base64",
+			String:         "aGVsbG8gd29ybGQh",
+			ExpectedResult: []Scheme{SchemeBase64, SchemeBase64URL},
+		},
+		{
+			Name:           "nothing",
+			String:         "!",
+			ExpectedResult: []Scheme{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			assert.Equal(t, tt.ExpectedResult, Sniff(tt.String))
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package bfh
+
+// Validate returns nil if bfh can accept the string for decoding, otherwise the reason why it can not
+// It is the error returning counterpart of IsAcceptable.
+func Validate(str string) error {
+	return StdEncoding.Validate(str)
+}
+
+// ValidateWellFormatted returns nil if the string is a well-formatted string, otherwise the reason why it is not
+// It is the error returning counterpart of IsWellFormatted.
+func ValidateWellFormatted(str string) error {
+	return StdEncoding.ValidateWellFormatted(str)
+}
+
+// ValidateStrict returns nil if the string is strict-compatible, otherwise the reason why it is not
+// It is the error returning counterpart of IsStrict.
+func ValidateStrict(str string) error {
+	return StdEncoding.ValidateStrict(str)
+}
+
+// Validate returns nil if bfh can accept the string for decoding, otherwise the reason why it can not
+func (enc *Encoding) Validate(str string) error {
+	offset, err := enc.validate(str)
+
+	return newValidationError(str, offset, err)
+}
+
+// ValidateWellFormatted returns nil if the string is a well-formatted string, otherwise the reason why it is not
+func (enc *Encoding) ValidateWellFormatted(str string) error {
+	offset, err := enc.validateWellFormatted(str)
+
+	return newValidationError(str, offset, err)
+}
+
+// ValidateStrict returns nil if the string is strict-compatible, otherwise the reason why it is not
+func (enc *Encoding) ValidateStrict(str string) error {
+	offset, err := enc.validateStrict(str)
+
+	return newValidationError(str, offset, err)
+}
+
+// paddingEnding describes how the padding bytes are represented at the end of an encoded string
+type paddingEnding struct {
+	// zeroDigits is the number of trailing digits only representing padding bits, therefore they must be zeros
+	zeroDigits int
+	// mask selects the padding bits of the digit preceding the zero digits
+	mask byte
+}
+
+// paddingEndings is indexed by the padding digit, padding*8 bits are represented by the last padding*8/5 digits and
+// the lowest padding*8%5 bits of the digit preceding them
+var paddingEndings = [5]paddingEnding{
+	{zeroDigits: 0, mask: 0x00},
+	{zeroDigits: 1, mask: 0x07},
+	{zeroDigits: 3, mask: 0x01},
+	{zeroDigits: 4, mask: 0x0f},
+	{zeroDigits: 6, mask: 0x03},
+}
+
+// newValidationError returns nil if err is nil, otherwise the CorruptInputError describing the problem at offset
+func newValidationError(str string, offset int, err error) error {
+	if err == nil {
+		return nil
+	}
+
+	return newCorruptInputError(str, offset, err)
+}
+
+// validate checks an acceptable string in a single pass, returning the kind and offset of the first problem found
+// It does not allocate, so that IsAcceptable does not have to either.
+func (enc *Encoding) validate(str string) (int, error) {
+	if !enc.paddingHeader {
+		digitCount, offset, err := enc.validateDigits(str, 0)
+		if err != nil {
+			return offset, err
+		}
+
+		if digitCount%8 != 0 {
+			return len(str), ErrInvalidLength
+		}
+
+		return 0, nil
+	}
+
+	// separators are ignored wherever they are, even before the padding digit, just like when decoding
+	first := enc.skipSeparators(str, 0)
+	if first == len(str) {
+		return len(str), ErrInvalidLength
+	}
+
+	padding, err := enc.validateHeaderDigit(str[first:])
+	if err != nil {
+		return first, err
+	}
+
+	digitCount, offset, err := enc.validateDigits(str, first+1)
+	if err != nil {
+		return offset, err
+	}
+
+	if digitCount%8 != 0 {
+		return len(str), ErrInvalidLength
+	}
+
+	return enc.validatePadding(str, padding, digitCount)
+}
+
+// validateWellFormatted checks a well-formatted string in a single pass, returning the kind and offset of the first
+// problem found
+func (enc *Encoding) validateWellFormatted(str string) (int, error) {
+	if !enc.paddingHeader {
+		return enc.validateStrict(str)
+	}
+
+	headerLength := enc.headerLength()
+	if len(str) < headerLength {
+		return len(str), ErrInvalidLength
+	}
+
+	padding, err := enc.validateHeaderDigit(str)
+	if err != nil {
+		return 0, err
+	}
+
+	if headerLength > 1 && str[1] != enc.separator {
+		return 1, ErrInvalidCharacter
+	}
+
+	digitCount, offset, err := enc.validateGroups(str, headerLength)
+	if err != nil {
+		return offset, err
+	}
+
+	return enc.validatePadding(str, padding, digitCount)
+}
+
+// validateStrict checks a strict-compatible string in a single pass, returning the kind and offset of the first problem
+// found
+func (enc *Encoding) validateStrict(str string) (int, error) {
+	_, offset, err := enc.validateGroups(str, 0)
+
+	return offset, err
+}
+
+// validateHeaderDigit returns the padding represented by the first character of str
+func (enc *Encoding) validateHeaderDigit(str string) (int, error) {
+	padding := enc.decodeMap[str[0]]
+	if padding == invalidDigit {
+		return 0, ErrInvalidCharacter
+	}
+
+	if padding > 4 {
+		return 0, ErrInvalidPadding
+	}
+
+	return int(padding), nil
+}
+
+// validateDigits checks that str only contains digits and separators starting at offset and returns the number of
+// digits found
+func (enc *Encoding) validateDigits(str string, offset int) (int, int, error) {
+	separatorCount := 0
+	for i := offset; i < len(str); i++ {
+		// the separator is never part of the alphabet
+		if enc.decodeMap[str[i]] == invalidDigit {
+			if str[i] != enc.separator {
+				return 0, i, ErrInvalidCharacter
+			}
+
+			separatorCount++
+		}
+	}
+
+	return len(str) - offset - separatorCount, 0, nil
+}
+
+// validateGroups checks that str consists of properly separated groups of digits starting at offset, the number of
+// digits being some multiple of 8, and returns the number of digits found
+func (enc *Encoding) validateGroups(str string, offset int) (int, int, error) {
+	digitCount, errOffset, err := enc.scanGroups(str, offset)
+	if err != nil {
+		return 0, errOffset, err
+	}
+
+	if digitCount%8 != 0 {
+		return 0, len(str), ErrInvalidLength
+	}
+
+	return digitCount, 0, nil
+}
+
+// scanGroups checks that str consists of properly separated groups of digits starting at offset and returns the number
+// of digits found
+func (enc *Encoding) scanGroups(str string, offset int) (int, int, error) {
+	digitCount := 0
+	for i := offset; i < len(str); {
+		groupEnd := len(str)
+		if enc.groupLength > 0 && i+enc.groupLength < groupEnd {
+			groupEnd = i + enc.groupLength
+		}
+
+		digitCount += groupEnd - i
+		for ; i < groupEnd; i++ {
+			if enc.decodeMap[str[i]] == invalidDigit {
+				return 0, i, ErrInvalidCharacter
+			}
+		}
+
+		if i == len(str) {
+			break
+		}
+
+		if str[i] != enc.separator {
+			return 0, i, ErrInvalidCharacter
+		}
+
+		i++
+	}
+
+	if len(str) > offset && str[len(str)-1] == enc.separator {
+		return 0, len(str), ErrInvalidLength
+	}
+
+	return digitCount, 0, nil
+}
+
+// validatePadding checks that the padding bits at the end of str are all zeros, returning ErrNonCanonical at the
+// offending digit otherwise
+// Only the digits representing padding bits are checked, walking backward from the end of the already validated str.
+func (enc *Encoding) validatePadding(str string, padding, digitCount int) (int, error) {
+	if digitCount == 0 {
+		if padding > 0 {
+			return enc.skipSeparators(str, 0), ErrInvalidPadding
+		}
+
+		return 0, nil
+	}
+
+	pe := paddingEndings[padding]
+	zeroDigits := pe.zeroDigits
+
+	for i := len(str) - 1; i >= 0; i-- {
+		if str[i] == enc.separator {
+			continue
+		}
+
+		if zeroDigits == 0 {
+			if enc.decodeMap[str[i]]&pe.mask != 0 {
+				return i, ErrNonCanonical
+			}
+
+			return 0, nil
+		}
+
+		if enc.decodeMap[str[i]] != 0 {
+			return i, ErrNonCanonical
+		}
+
+		zeroDigits--
+	}
+
+	return 0, nil
+}
@@ -0,0 +1,243 @@
+package bfh
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Validate(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		tests := []struct {
+			Name   string
+			String string
+		}{
+			{
+				Name:   "well-formatted",
+				String: "4-zwga-e07x-2400-0000",
+			},
+			{
+				Name:   "misplaced dashes",
+				String: "4zw-gae07x2400-0000",
+			},
+			{
+				Name:   "no data",
+				String: "0",
+			},
+			{
+				Name:   "leading separators",
+				String: "--4-zw00-0000",
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				assert.NoError(t, Validate(tt.String))
+
+				_, err := DecodeStr(tt.String)
+				assert.NoError(t, err, fmt.Sprintf("Failing value: %s", tt.String))
+			})
+		}
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedErr    error
+			ExpectedOffset int
+		}{
+			{
+				Name:           "empty",
+				String:         "",
+				ExpectedErr:    ErrInvalidLength,
+				ExpectedOffset: 0,
+			},
+			{
+				Name:           "invalid character",
+				String:         "4-zwga-e07x-24u0-0000",
+				ExpectedErr:    ErrInvalidCharacter,
+				ExpectedOffset: 14,
+			},
+			{
+				Name:           "invalid length",
+				String:         "4-zwga-e07x-2400-000",
+				ExpectedErr:    ErrInvalidLength,
+				ExpectedOffset: 20,
+			},
+			{
+				Name:           "non-zero padding",
+				String:         "4-zwga-e07x-2400-0100",
+				ExpectedErr:    ErrNonCanonical,
+				ExpectedOffset: 18,
+			},
+			{
+				Name:           "padding with no data",
+				String:         "1",
+				ExpectedErr:    ErrInvalidPadding,
+				ExpectedOffset: 0,
+			},
+			{
+				Name:           "separators only",
+				String:         "--",
+				ExpectedErr:    ErrInvalidLength,
+				ExpectedOffset: 2,
+			},
+			{
+				Name:           "invalid padding after leading separator",
+				String:         "-9-zw00-0000",
+				ExpectedErr:    ErrInvalidPadding,
+				ExpectedOffset: 1,
+			},
+			{
+				Name:           "padding with no data after leading separator",
+				String:         "-1",
+				ExpectedErr:    ErrInvalidPadding,
+				ExpectedOffset: 1,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				err := Validate(tt.String)
+
+				require.Error(t, err, fmt.Sprintf("Failing value: %s", tt.String))
+				assert.ErrorIs(t, err, tt.ExpectedErr)
+
+				var corruptErr *CorruptInputError
+				require.ErrorAs(t, err, &corruptErr)
+				assert.Equal(t, tt.ExpectedOffset, corruptErr.Offset)
+			})
+		}
+	})
+}
+
+func Test_ValidateWellFormatted(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.NoError(t, ValidateWellFormatted("4-zwga-e07x-2400-0000"))
+		assert.NoError(t, ValidateWellFormatted("0-"))
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedErr    error
+			ExpectedOffset int
+		}{
+			{
+				Name:           "missing header separator",
+				String:         "4zwga-e07x-2400-0000",
+				ExpectedErr:    ErrInvalidCharacter,
+				ExpectedOffset: 1,
+			},
+			{
+				Name:           "misplaced dash",
+				String:         "4-zwg-ae07x-2400-0000",
+				ExpectedErr:    ErrInvalidCharacter,
+				ExpectedOffset: 5,
+			},
+			{
+				Name:           "trailing dash",
+				String:         "4-zwga-e07x-2400-0000-",
+				ExpectedErr:    ErrInvalidLength,
+				ExpectedOffset: 22,
+			},
+			{
+				Name:           "non-zero trailing bits",
+				String:         "4-zwga-e07x-2401-0000",
+				ExpectedErr:    ErrNonCanonical,
+				ExpectedOffset: 15,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				err := ValidateWellFormatted(tt.String)
+
+				require.Error(t, err, fmt.Sprintf("Failing value: %s", tt.String))
+				assert.ErrorIs(t, err, tt.ExpectedErr)
+
+				var corruptErr *CorruptInputError
+				require.ErrorAs(t, err, &corruptErr)
+				assert.Equal(t, tt.ExpectedOffset, corruptErr.Offset)
+			})
+		}
+	})
+}
+
+func Test_ValidateStrict(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		assert.NoError(t, ValidateStrict("zwga-e07x"))
+		assert.NoError(t, ValidateStrict(""))
+	})
+
+	t.Run("fail", func(t *testing.T) {
+		tests := []struct {
+			Name           string
+			String         string
+			ExpectedErr    error
+			ExpectedOffset int
+		}{
+			{
+				Name:           "header",
+				String:         "0-zwga-e07x",
+				ExpectedErr:    ErrInvalidCharacter,
+				ExpectedOffset: 1,
+			},
+			{
+				Name:           "invalid length",
+				String:         "zwga-e0",
+				ExpectedErr:    ErrInvalidLength,
+				ExpectedOffset: 7,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.Name, func(t *testing.T) {
+				err := ValidateStrict(tt.String)
+
+				require.Error(t, err, fmt.Sprintf("Failing value: %s", tt.String))
+				assert.ErrorIs(t, err, tt.ExpectedErr)
+
+				var corruptErr *CorruptInputError
+				require.ErrorAs(t, err, &corruptErr)
+				assert.Equal(t, tt.ExpectedOffset, corruptErr.Offset)
+			})
+		}
+	})
+}
+
+func Test_ValidatorAllocations(t *testing.T) {
+	tests := []struct {
+		Name   string
+		String string
+	}{
+		{
+			Name:   "valid",
+			String: "4-zwga-e07x-2400-0000",
+		},
+		{
+			Name:   "invalid character",
+			String: "4-zwga-e07x-24u0-0000",
+		},
+		{
+			Name:   "non-canonical",
+			String: "4-zwga-e07x-2400-000z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.Name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() {
+				IsAcceptable(tt.String)
+				IsWellFormatted(tt.String)
+				IsStrict(tt.String)
+			})
+
+			assert.Equal(t, float64(0), allocs)
+		})
+	}
+}